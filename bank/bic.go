@@ -0,0 +1,58 @@
+package bank
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+var (
+	// reBic matches a BIC: the bank code, the country code, the location code and an optional branch code.
+	reBic = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[0-9A-Z]{2}([0-9A-Z]{3})?$`)
+	// reCreditorID matches a SEPA Creditor Identifier: the country code, the control digits,
+	// the creditor business code and the national identifier.
+	reCreditorID = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[0-9A-Z]{3}[0-9A-Z]{1,28}$`)
+
+	// BIC validates a BIC (SWIFT code) of 8 or 11 characters. The country code must be
+	// an ISO 3166 alpha-2 code, the same table is used by is.CountryCode2.
+	BIC = &bicRule{code: 3210}
+	// SEPACreditorID validates a SEPA Creditor Identifier including its mod 97 control digits.
+	SEPACreditorID = validation.NewStringRule(isCreditorID, 3220)
+)
+
+type bicRule struct {
+	code int
+}
+
+// Validate checks if the given value is a valid BIC. If the country code is unknown it is returned in the args.
+func (r *bicRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if !reBic.MatchString(s) {
+		code = r.code
+		return
+	}
+	// Kosovo is not in ISO 3166 yet, but has the user-assigned code XK in SWIFT.
+	if country := s[4:6]; country != "XK" && !govalidator.IsISO3166Alpha2(country) {
+		return 3211, []interface{}{country}
+	}
+	return
+}
+
+// isCreditorID checks a SEPA Creditor Identifier. The creditor business code is not included in the control digits.
+func isCreditorID(value string) bool {
+	value = NormalizeIBAN(value)
+	return reCreditorID.MatchString(value) && checksum.Mod97(value[7:]+value[:4]) == 1
+}
@@ -0,0 +1,55 @@
+package bank
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBIC(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"", 0, nil},
+		{"DEUTDEFF", 0, nil},
+		{"DEUTDEFF500", 0, nil},
+		{"NEDSZAJJXXX", 0, nil},
+		{" deutdeff ", 0, nil},
+		{"RBKOXKPR", 0, nil},
+		{"DEUTQQFF", 3211, []interface{}{"QQ"}},
+		{"DEUTDEF", 3210, nil},
+		{"DEUTDEFF50", 3210, nil},
+		{"DEU1DEFF", 3210, nil},
+		{"DEUT DE FF", 3210, nil},
+	}
+
+	for _, test := range tests {
+		code, args := BIC.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%v: got %v %v, want %v %v", test.value, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestSEPACreditorID(t *testing.T) {
+	tests := []struct {
+		value string
+		code  int
+	}{
+		{"", 0},
+		{"DE98ZZZ09999999999", 0},
+		{"de98 zzz 0999 9999 999", 0},
+		// the creditor business code is not included in the control digits
+		{"DE98ABC09999999999", 0},
+		{"DE97ZZZ09999999999", 3220},
+		{"DE98ZZZ09999999998", 3220},
+		{"DE98ZZZ", 3220},
+	}
+
+	for _, test := range tests {
+		if code, _ := SEPACreditorID.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
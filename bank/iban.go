@@ -0,0 +1,121 @@
+// Package bank provides validation rules for international bank identifiers.
+package bank
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+var (
+	reIban       = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[0-9A-Z]+$`)
+	reBbanFormat = regexp.MustCompile(`([0-9]+)([nac])`)
+
+	// bbanFormats are the BBAN structures from the SWIFT IBAN registry: "n" stands for digits,
+	// "a" for upper case letters and "c" for both.
+	bbanFormats = map[string]string{
+		"AD": "4n4n12c", "AE": "3n16n", "AL": "8n16c", "AT": "5n11n", "AZ": "4a20c",
+		"BA": "3n3n8n2n", "BE": "3n7n2n", "BG": "4a4n2n8c", "BH": "4a14c", "BI": "5n5n11n2n",
+		"BR": "8n5n10n1a1c", "BY": "4c4n16c", "CH": "5n12c", "CR": "4n14n", "CY": "3n5n16c",
+		"CZ": "4n6n10n", "DE": "8n10n", "DJ": "5n5n11n2n", "DK": "4n9n1n", "DO": "4c20n",
+		"EE": "2n2n11n1n", "EG": "4n4n17n", "ES": "4n4n1n1n10n", "FI": "3n11n", "FK": "2a12n",
+		"FO": "4n9n1n", "FR": "5n5n11c2n", "GB": "4a6n8n", "GE": "2a16n", "GI": "4a15c",
+		"GL": "4n9n1n", "GR": "3n4n16c", "GT": "4c20c", "HR": "7n10n", "HU": "3n4n1n15n1n",
+		"IE": "4a6n8n", "IL": "3n3n13n", "IQ": "4a3n12n", "IS": "4n2n6n10n", "IT": "1a5n5n12c",
+		"JO": "4a4n18c", "KW": "4a22c", "KZ": "3n13c", "LB": "4n20c", "LC": "4a24c",
+		"LI": "5n12c", "LT": "5n11n", "LU": "3n13c", "LV": "4a13c", "LY": "3n3n15n",
+		"MC": "5n5n11c2n", "MD": "2c18c", "ME": "3n13n2n", "MK": "3n10c2n", "MN": "4n12n",
+		"MR": "5n5n11n2n", "MT": "4a5n18c", "MU": "4a2n2n12n3n3a", "NI": "4a20n", "NL": "4a10n",
+		"NO": "4n6n1n", "OM": "3n16c", "PK": "4a16c", "PL": "8n16n", "PS": "4a21c",
+		"PT": "4n4n11n2n", "QA": "4a21c", "RO": "4a16c", "RS": "3n13n2n", "RU": "9n5n15c",
+		"SA": "2n18c", "SC": "4a2n2n16n3a", "SD": "2n12n", "SE": "3n16n1n", "SI": "5n8n2n",
+		"SK": "4n6n10n", "SM": "1a5n5n12c", "SO": "4n3n12n", "ST": "8n11n2n", "SV": "4a20n",
+		"TL": "3n14n2n", "TN": "2n3n13n2n", "TR": "5n1n16c", "UA": "6n19c", "VA": "3n15n",
+		"VG": "4a16n", "XK": "4n10n2n",
+	}
+
+	// ibanCountries are the compiled BBAN structures keyed by the country code.
+	ibanCountries = map[string]ibanCountry{}
+
+	// ibanSeparators are removed from an IBAN before it is checked.
+	ibanSeparators = strings.NewReplacer(" ", "", "\u00a0", "", "-", "")
+
+	// IBAN validates an International Bank Account Number (ISO 13616): the length and the BBAN
+	// structure of the country and the mod 97 control digits. Spaces and lower case letters are allowed.
+	IBAN = &ibanRule{code: 3200}
+	// IBANFormat is a filter that converts an IBAN into the electronic form: upper case without spaces.
+	IBANFormat = validation.NewStringFilter(NormalizeIBAN)
+)
+
+// ibanCountry is the IBAN structure of a country.
+type ibanCountry struct {
+	length int
+	re     *regexp.Regexp
+}
+
+func init() {
+	for country, format := range bbanFormats {
+		length := 4
+		var expr strings.Builder
+		expr.WriteString("^")
+		for _, m := range reBbanFormat.FindAllStringSubmatch(format, -1) {
+			n, _ := strconv.Atoi(m[1])
+			length += n
+			switch m[2] {
+			case "n":
+				expr.WriteString("[0-9]{" + m[1] + "}")
+			case "a":
+				expr.WriteString("[A-Z]{" + m[1] + "}")
+			default:
+				expr.WriteString("[0-9A-Z]{" + m[1] + "}")
+			}
+		}
+		expr.WriteString("$")
+		ibanCountries[country] = ibanCountry{length: length, re: regexp.MustCompile(expr.String())}
+	}
+}
+
+// NormalizeIBAN returns the IBAN in the electronic form: upper case without spaces and dashes.
+func NormalizeIBAN(value string) string {
+	return strings.ToUpper(ibanSeparators.Replace(strings.TrimSpace(value)))
+}
+
+type ibanRule struct {
+	code int
+}
+
+// Validate checks if the given value is a valid IBAN. If its length does not match the one of its
+// country, the country and the expected length are returned in the args.
+func (r *ibanRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	s = NormalizeIBAN(s)
+	if !reIban.MatchString(s) {
+		code = r.code
+		return
+	}
+
+	country, ok := ibanCountries[s[:2]]
+	switch {
+	case !ok:
+		return 3202, []interface{}{s[:2]}
+	case len(s) != country.length:
+		return 3201, []interface{}{s[:2], country.length}
+	case !country.re.MatchString(s[4:]):
+		code = 3203
+	case checksum.Mod97(s[4:]+s[:4]) != 1:
+		code = 3204
+	}
+	return
+}
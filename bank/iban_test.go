@@ -0,0 +1,44 @@
+package bank
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIBAN(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"", 0, nil},
+		{"DE89370400440532013000", 0, nil},
+		{"GB82WEST12345698765432", 0, nil},
+		{"FR1420041010050500013M02606", 0, nil},
+		{"NL91ABNA0417164300", 0, nil},
+		{"BE68539007547034", 0, nil},
+		{"de89 3704 0044 0532 0130 00", 0, nil},
+		{"DE89-3704-0044-0532-0130-00", 0, nil},
+		{"DE89370400440532013001", 3204, nil},
+		{"DE88370400440532013000", 3204, nil},
+		{"GB82WEST12345698765433", 3204, nil},
+		{"DE8937040044053201300", 3201, []interface{}{"DE", 22}},
+		{"GB82WEST1234569876543A", 3203, nil},
+		{"XX82WEST12345698765432", 3202, []interface{}{"XX"}},
+		{"DE8A370400440532013000", 3200, nil},
+		{42, 1005, nil},
+	}
+
+	for _, test := range tests {
+		code, args := IBAN.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%v: got %v %v, want %v %v", test.value, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestIBANFormat(t *testing.T) {
+	if got := IBANFormat.Filter(" de89 3704 0044 0532 0130 00 "); got != "DE89370400440532013000" {
+		t.Errorf("got %v", got)
+	}
+}
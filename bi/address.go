@@ -0,0 +1,133 @@
+package bi
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+var (
+	// rePostalIndex matches a Russian postal index: six digits, the first of them from 1 to 6.
+	rePostalIndex = regexp.MustCompile(`^[1-6][0-9]{5}$`)
+	// reGUID matches a GUID of an address object of FIAS or GAR.
+	reGUID = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	// PostalIndex validates a 6-digit Russian postal index.
+	PostalIndex = &postalIndexRule{code: 2983}
+	// FIASGUID validates a GUID of an address object or a house of FIAS or GAR.
+	FIASGUID = validation.NewStringRule(reGUID.MatchString, 2985)
+)
+
+type postalIndexRule struct {
+	ranges *PostalRanges
+	region interface{}
+	code   int
+}
+
+// PostalIndexOf returns a rule that checks a postal index against the prefix ranges of the region.
+// Pass a pointer to the region field so that its current value is used. If the region is nil,
+// the index must belong to the ranges of any region.
+func PostalIndexOf(ranges *PostalRanges, region interface{}) *postalIndexRule {
+	return &postalIndexRule{ranges: ranges, region: region, code: 2983}
+}
+
+func (r *postalIndexRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code, 6)
+	if code != 0 {
+		return
+	}
+	if !rePostalIndex.MatchString(s) {
+		code = r.code
+		return
+	}
+	if r.ranges == nil {
+		return
+	}
+
+	region := ""
+	if v, isNil := validation.Indirect(r.region); !isNil && !validation.IsEmpty(v) {
+		region, _, _ = identifierText(v)
+	}
+	if !r.ranges.Contains(region, s) {
+		code, args = 2984, []interface{}{s}
+	}
+	return
+}
+
+// PostalRanges are the postal index prefix ranges of the regions.
+type PostalRanges struct {
+	ranges map[string][][2]string
+}
+
+// LoadPostalRanges loads the postal index ranges from a local CSV file, see ReadPostalRanges.
+func LoadPostalRanges(path string) (*PostalRanges, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadPostalRanges(f)
+}
+
+// ReadPostalRanges reads the postal index ranges from semicolon separated CSV data.
+// Every line holds the region code and the first and the last index prefix of a range, e.g. "77;101;135".
+// A region may have several lines. Lines with a non-numeric prefix, such as a header, are skipped.
+func ReadPostalRanges(r io.Reader) (*PostalRanges, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PostalRanges{ranges: make(map[string][][2]string)}
+	for _, rec := range records {
+		if len(rec) < 3 {
+			continue
+		}
+		region, from, to := strings.TrimSpace(rec[0]), strings.TrimSpace(rec[1]), strings.TrimSpace(rec[2])
+		if _, err := strconv.Atoi(from); err != nil || len(from) != len(to) || len(from) > 6 {
+			continue
+		}
+		p.ranges[region] = append(p.ranges[region], [2]string{from, to})
+	}
+	return p, nil
+}
+
+// Contains checks if the index falls within one of the ranges of the region, or of any region if it is empty.
+func (p *PostalRanges) Contains(region, index string) bool {
+	if region != "" {
+		return inPostalRanges(p.ranges[region], index)
+	}
+	for _, ranges := range p.ranges {
+		if inPostalRanges(ranges, index) {
+			return true
+		}
+	}
+	return false
+}
+
+func inPostalRanges(ranges [][2]string, index string) bool {
+	for _, r := range ranges {
+		if len(r[0]) <= len(index) {
+			prefix := index[:len(r[0])]
+			if prefix >= r[0] && prefix <= r[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
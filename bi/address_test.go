@@ -0,0 +1,84 @@
+package bi
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestPostalIndex(t *testing.T) {
+	ranges, err := ReadPostalRanges(strings.NewReader("region;from;to\n77;101;135\n77;140;140\n16;420;423\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	region := "77"
+
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"empty", PostalIndex, "", 0},
+		{"index", PostalIndex, "101000", 0},
+		{"integer", PostalIndex, 420111, 0},
+		{"starts with 0", PostalIndex, "010000", 2983},
+		{"starts with 7", PostalIndex, "701000", 2983},
+		{"five digits", PostalIndex, "10100", 2983},
+		{"lost zero", PostalIndex, 10000, 2809},
+		{"in the region", PostalIndexOf(ranges, &region), "135999", 0},
+		{"second range of the region", PostalIndexOf(ranges, &region), "140050", 0},
+		{"out of the region", PostalIndexOf(ranges, &region), "136000", 2984},
+		{"another region", PostalIndexOf(ranges, &region), "420111", 2984},
+		{"any region", PostalIndexOf(ranges, nil), "420111", 0},
+		{"no region", PostalIndexOf(ranges, nil), "500000", 2984},
+		{"numeric region", PostalIndexOf(ranges, 16), "423999", 0},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestLoadPostalRanges(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ranges.csv")
+	if err := ioutil.WriteFile(path, []byte("77;101;135\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ranges, err := LoadPostalRanges(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ranges.Contains("77", "101000") || ranges.Contains("77", "100999") {
+		t.Errorf("got %v", ranges.ranges)
+	}
+
+	if _, err := LoadPostalRanges(path + ".missing"); err == nil {
+		t.Error("missing file: expected an error")
+	}
+}
+
+func TestFIASGUID(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"0c5b2444-70a0-4932-980c-b4dc0d3f02b5", 0},
+		{"0C5B2444-70A0-4932-980C-B4DC0D3F02B5", 0},
+		{"0c5b2444-70a0-4932-980c-b4dc0d3f02b", 2985},
+		{"0c5b244470a04932980cb4dc0d3f02b5", 2985},
+		{"0c5b2444-70a0-4932-980c-b4dc0d3f02g5", 2985},
+	}
+
+	for _, test := range tests {
+		if code, _ := FIASGUID.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
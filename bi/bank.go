@@ -0,0 +1,203 @@
+package bi
+
+import (
+	"regexp"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+const (
+	// bikCountry is the country code the BIK of a Russian bank starts with.
+	bikCountry = "04"
+	// bikTreasury and bikTreasuryDivision are the codes the BIK of a territorial body of the Federal Treasury
+	// (TOFK) starts with, used for budget payments.
+	bikTreasury         = "00"
+	bikTreasuryDivision = "01"
+	// corrAccountPrefix is the balance account of correspondent accounts of credit organizations.
+	corrAccountPrefix = "30101"
+	// treasuryAccountPrefix is the balance account of the single treasury account (EKS) of a TOFK, given
+	// as the correspondent account in budget payments.
+	treasuryAccountPrefix = "40102"
+	// treasuryAccountBalance is the balance account of the treasury accounts, which have no control key.
+	treasuryAccountBalance = "03"
+	// bikCashCenter is the BIK suffix of the settlement cash centers of the Bank of Russia.
+	bikCashCenter = "000"
+)
+
+var (
+	reBik     = regexp.MustCompile(`^[0-9]{9}$`)
+	reAccount = regexp.MustCompile(`^[0-9]{20}$`)
+
+	// accountWeights are the weights of the control key algorithm of the Bank of Russia.
+	accountWeights = []int{7, 1, 3}
+
+	// bikRegions are the OKATO region codes used in the BIK.
+	bikRegions = map[string]struct{}{
+		"01": {}, "03": {}, "04": {}, "05": {}, "07": {}, "08": {}, "10": {}, "11": {}, "12": {}, "14": {},
+		"15": {}, "17": {}, "18": {}, "19": {}, "20": {}, "21": {}, "22": {}, "23": {}, "24": {}, "25": {},
+		"26": {}, "27": {}, "28": {}, "29": {}, "30": {}, "32": {}, "33": {}, "34": {}, "35": {}, "36": {},
+		"37": {}, "38": {}, "39": {}, "40": {}, "41": {}, "42": {}, "43": {}, "44": {}, "45": {}, "46": {},
+		"47": {}, "49": {}, "50": {}, "52": {}, "53": {}, "54": {}, "55": {}, "56": {}, "57": {}, "58": {},
+		"60": {}, "61": {}, "63": {}, "64": {}, "65": {}, "66": {}, "67": {}, "68": {}, "69": {}, "70": {},
+		"71": {}, "73": {}, "75": {}, "76": {}, "77": {}, "78": {}, "79": {}, "80": {}, "81": {}, "82": {},
+		"83": {}, "84": {}, "85": {}, "86": {}, "87": {}, "88": {}, "89": {}, "90": {}, "91": {}, "92": {},
+		"93": {}, "94": {}, "95": {}, "96": {}, "97": {}, "98": {}, "99": {},
+	}
+
+	// BIK validates a 9-digit bank identification code of a Russian bank or of a TOFK.
+	BIK = &bikRule{code: 2900}
+	// Account validates the format and the currency code of a 20-digit settlement account.
+	// Use AccountOf to check the control key against the BIK.
+	Account = &accountRule{code: 2910}
+	// CorrAccount validates the format and the currency code of a 20-digit correspondent account.
+	// Use CorrAccountOf to check the control key against the BIK.
+	CorrAccount = &accountRule{corr: true, code: 2920}
+)
+
+type bikRule struct {
+	code int
+}
+
+func (r *bikRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code, 9)
+	if code != 0 {
+		return
+	}
+	code = checkBik(s)
+	return
+}
+
+func checkBik(s string) int {
+	if !reBik.MatchString(s) {
+		return 2900
+	}
+	if s[:2] != bikCountry && !isTreasuryBik(s) {
+		return 2901
+	}
+	if _, ok := bikRegions[s[2:4]]; !ok {
+		return 2902
+	}
+	return 0
+}
+
+// isTreasuryBik checks if the BIK belongs to a territorial body of the Federal Treasury.
+func isTreasuryBik(s string) bool {
+	return s[:2] == bikTreasury || s[:2] == bikTreasuryDivision
+}
+
+// AccountOf returns a rule that checks a settlement account against the BIK of the bank.
+// Pass a pointer to the BIK field so that its current value is used.
+func AccountOf(bik interface{}) *accountRule {
+	return &accountRule{bik: bik, code: 2910}
+}
+
+// CorrAccountOf returns a rule that checks a correspondent account against the BIK of the bank.
+// Pass a pointer to the BIK field so that its current value is used.
+func CorrAccountOf(bik interface{}) *accountRule {
+	return &accountRule{bik: bik, corr: true, code: 2920}
+}
+
+type accountRule struct {
+	bik  interface{}
+	corr bool
+	code int
+}
+
+func (r *accountRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code, 20)
+	if code != 0 {
+		return
+	}
+	if code, args = checkAccount(s, r.corr); code != 0 {
+		return
+	}
+
+	bik, isNil := validation.Indirect(r.bik)
+	if isNil || validation.IsEmpty(bik) {
+		return
+	}
+	b, c := identifier(bik, 2903, 9)
+	if c != 0 || checkBik(b) != 0 {
+		code = 2903
+		return
+	}
+	code = checkAccountKey(s, b, r.corr)
+	return
+}
+
+// checkAccount checks the format of an account and the currency code in its digits 6-8.
+func checkAccount(s string, corr bool) (code int, args []interface{}) {
+	if !reAccount.MatchString(s) {
+		code = 2910
+		return
+	}
+	if corr && s[:5] != corrAccountPrefix && s[:5] != treasuryAccountPrefix {
+		code = 2920
+		return
+	}
+	if _, ok := currencyCodes[s[5:8]]; !ok {
+		code, args = 2912, []interface{}{s[5:8]}
+	}
+	return
+}
+
+// checkAccountKey checks the control key of an account. The key is calculated over the account
+// prefixed with the last three digits of the BIK for a settlement account in a credit organization,
+// or with "0" and the fifth and sixth digits of the BIK for a correspondent account, an account
+// in a settlement cash center and the single treasury account of a TOFK. The treasury accounts
+// of a TOFK have no control key.
+func checkAccountKey(account, bik string, corr bool) int {
+	treasury := isTreasuryBik(bik)
+	switch {
+	case corr && treasury && account[:5] != treasuryAccountPrefix:
+		return 2921
+	case corr && !treasury && (account[:5] != corrAccountPrefix || account[17:] != bik[6:]):
+		return 2921
+	case !corr && treasury && account[:2] == treasuryAccountBalance:
+		return 0
+	}
+
+	prefix := bik[6:]
+	if corr || bik[6:] == bikCashCenter {
+		prefix = "0" + bik[4:6]
+	}
+
+	sum := 0
+	for i, c := range prefix + account {
+		sum += int(c-'0') * accountWeights[i%len(accountWeights)] % 10
+	}
+	if sum%10 != 0 {
+		return 2911
+	}
+	return 0
+}
+
+// BankRequisites is a struct-level validator for the payment requisites of a Russian bank account.
+// Embed it or call Validate directly to check the account and the correspondent account against the BIK.
+type BankRequisites struct {
+	BIK         string `json:"bik"`
+	CorrAccount string `json:"corrAccount"`
+	Account     string `json:"account"`
+}
+
+// Validate checks the BIK, the correspondent account and the settlement account together.
+// Empty fields are not checked, use validation.Required on them to make sure they are set.
+func (r BankRequisites) Validate() (code int, args []interface{}) {
+	if code, args = BIK.Validate(r.BIK); code != 0 {
+		return
+	}
+	if code, args = CorrAccountOf(r.BIK).Validate(r.CorrAccount); code != 0 {
+		return
+	}
+	return AccountOf(r.BIK).Validate(r.Account)
+}
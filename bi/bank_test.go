@@ -0,0 +1,87 @@
+package bi
+
+import (
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestBIK(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"044525225", 0},
+		{"044 525 225", 0},
+		{"004525988", 0},
+		{"017003983", 0},
+		{"034525225", 2901},
+		{"040625225", 2902},
+		{"04452522", 2900},
+		{"04452522A", 2900},
+		{44525225, 2809},
+	}
+
+	for _, test := range tests {
+		if code, _ := BIK.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
+
+func TestAccount(t *testing.T) {
+	bik := "044525225"
+
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"empty", AccountOf(&bik), "", 0},
+		{"account", AccountOf(&bik), "40702810238000001234", 0},
+		{"account key off", AccountOf(&bik), "40702810338000001234", 2911},
+		{"account digit off", AccountOf(&bik), "40702810238000001235", 2911},
+		{"account without a bik", Account, "40702810338000001234", 0},
+		{"currency", Account, "40702000238000001234", 2912},
+		{"short", Account, "4070281023800000123", 2910},
+		{"corr account", CorrAccountOf(&bik), "30101810400000000225", 0},
+		{"corr account digit off", CorrAccountOf(&bik), "30101810500000000225", 2911},
+		{"corr account of another bank", CorrAccountOf(&bik), "30101810400000000226", 2921},
+		{"corr account prefix", CorrAccount, "30102810400000000225", 2920},
+		{"invalid bik", AccountOf("04452522"), "40702810238000001234", 2903},
+		{"treasury EKS", CorrAccountOf("004525988"), "40102810545370000003", 0},
+		{"treasury EKS digit off", CorrAccountOf("004525988"), "40102810545370000004", 2911},
+		{"treasury division EKS", CorrAccountOf("017003983"), "40102810445370000059", 0},
+		{"treasury EKS of a bank", CorrAccountOf(&bik), "40102810545370000003", 2921},
+		{"bank corr account of the treasury", CorrAccountOf("004525988"), "30101810400000000225", 2921},
+		{"treasury account", AccountOf("004525988"), "03100643000000017300", 0},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestBankRequisites(t *testing.T) {
+	tests := []struct {
+		tag  string
+		req  BankRequisites
+		code int
+	}{
+		{"empty", BankRequisites{}, 0},
+		{"bank", BankRequisites{BIK: "044525225", CorrAccount: "30101810400000000225", Account: "40702810238000001234"}, 0},
+		{"treasury", BankRequisites{BIK: "004525988", CorrAccount: "40102810545370000003", Account: "03100643000000017300"}, 0},
+		{"bik", BankRequisites{BIK: "044525226", CorrAccount: "30101810400000000225"}, 2921},
+		{"account", BankRequisites{BIK: "044525225", Account: "40702810238000001235"}, 2911},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.req.Validate(); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package bi
+
+import (
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// reBirthCertificate matches a birth certificate: a series of a Roman numeral and two Cyrillic letters
+// separated by a hyphen, followed by a six-digit number, e.g. "IV-МЮ 123456" or "IV-МЮ №123456".
+// The separators are optional since they are removed from the value before it is matched.
+var reBirthCertificate = regexp.MustCompile(`^([IVXLC]+)-?[А-ЯЁ]{2} ?№? ?[0-9]{6}$`)
+
+var romanValues = map[byte]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100}
+
+// BirthCertificate validates the series and the number of a Russian birth certificate.
+var BirthCertificate = &birthCertificateRule{code: 2955}
+
+type birthCertificateRule struct {
+	code int
+}
+
+func (r *birthCertificateRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code)
+	if code != 0 {
+		return
+	}
+	m := reBirthCertificate.FindStringSubmatch(s)
+	if m == nil {
+		code = r.code
+		return
+	}
+	if !isRoman(m[1]) {
+		code = 2956
+	}
+	return
+}
+
+// isRoman checks if the string is a Roman numeral written in the canonical form.
+func isRoman(s string) bool {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		v := romanValues[s[i]]
+		if i+1 < len(s) && v < romanValues[s[i+1]] {
+			n -= v
+		} else {
+			n += v
+		}
+	}
+	return n > 0 && toRoman(n) == s
+}
+
+func toRoman(n int) string {
+	values := []int{100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+
+	var b strings.Builder
+	for i, v := range values {
+		for n >= v {
+			b.WriteString(symbols[i])
+			n -= v
+		}
+	}
+	return b.String()
+}
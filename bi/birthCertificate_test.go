@@ -0,0 +1,32 @@
+package bi
+
+import "testing"
+
+func TestBirthCertificate(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"IV-МЮ 123456", 0},
+		{"IV-МЮ №123456", 0},
+		{"XIX-АБ 123456", 0},
+		{"IIII-МЮ 123456", 2956},
+		{"VX-МЮ 123456", 2956},
+		{"IV-MU 123456", 2955},
+		{"IV-МЮ 12345", 2955},
+		{"IV МЮ 123456", 0},
+		{"IVМЮ123456", 0},
+		{123456, 2955},
+	}
+
+	if code, _ := WithSeparators("-", BirthCertificate).Validate("IV МЮ 123456"); code != 2955 {
+		t.Errorf("without spaces as separators: got %v, want 2955", code)
+	}
+
+	for _, test := range tests {
+		if code, _ := BirthCertificate.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Package by provides validation rules for the identification numbers of Belarus.
+package by
+
+import (
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/bi"
+)
+
+const (
+	// unpAlphabet gives the values of the characters in the first position.
+	unpAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// unpLetters are the letters allowed in the second position, their index is the value.
+	unpLetters = "ABCEHKMOPT"
+)
+
+var (
+	// reUnp matches a UNP: nine digits for a legal entity, or a letter in the first two
+	// positions for an individual entrepreneur.
+	reUnp = regexp.MustCompile(`^[1-7ABCEHKMOPT][0-9ABCEHKMOPT][0-9]{7}$`)
+
+	// unpWeights are the weights of the first eight characters.
+	unpWeights = []int{29, 23, 19, 17, 13, 7, 5, 3}
+
+	// cyrillicToLatin replaces the Cyrillic letters that look like the Latin ones allowed in the UNP.
+	cyrillicToLatin = strings.NewReplacer("А", "A", "В", "B", "С", "C", "Е", "E", "Н", "H", "К", "K", "М", "M", "О", "O", "Р", "P", "Т", "T")
+
+	// UNP validates a 9-character payer account number.
+	UNP = &unpRule{code: 3020}
+)
+
+type unpRule struct {
+	code int
+}
+
+func (r *unpRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := bi.Identifier(value, r.code, 9)
+	if code != 0 {
+		return
+	}
+	s = cyrillicToLatin.Replace(strings.ToUpper(s))
+	if !reUnp.MatchString(s) {
+		code = r.code
+		return
+	}
+
+	if !checkUnp(s) {
+		code = 3021
+	}
+	return
+}
+
+func checkUnp(s string) bool {
+	sum := strings.IndexByte(unpAlphabet, s[0]) * unpWeights[0]
+	if i := strings.IndexByte(unpLetters, s[1]); i >= 0 {
+		sum += i * unpWeights[1]
+	} else {
+		sum += int(s[1]-'0') * unpWeights[1]
+	}
+	for i := 2; i < len(unpWeights); i++ {
+		sum += int(s[i]-'0') * unpWeights[i]
+	}
+	return sum%11 < 10 && sum%11 == int(s[8]-'0')
+}
@@ -0,0 +1,31 @@
+package by
+
+import "testing"
+
+func TestUNP(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"100582333", 0},
+		{"190273541", 0},
+		{"AB1234563", 0},
+		{"ab1234563", 0},
+		{"АВ1234563", 0},
+		{"MA1234569", 0},
+		{100582333, 0},
+		{"100582334", 3021},
+		{"100582433", 3021},
+		{"AB1234564", 3021},
+		{"800582333", 3020},
+		{"AD1234563", 3020},
+		{"10058233", 3020},
+	}
+
+	for _, test := range tests {
+		if code, _ := UNP.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
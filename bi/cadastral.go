@@ -0,0 +1,198 @@
+package bi
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// maxCadastralOkrug is the code of the last cadastral okrug, the one of Sevastopol.
+const maxCadastralOkrug = 91
+
+// defaultCadastralDivision holds the last district and the last quarter of Moscow, Saint Petersburg,
+// Crimea and Sevastopol in the format read by ReadCadastralDivision. The districts of the other okrugs
+// may take any two-digit code and their quarters any number of six or seven digits, unless a loaded
+// division bounds them.
+const defaultCadastralDivision = `
+77;22;0999999
+78;42;0999999
+90;25;999999
+91;04;999999
+`
+
+// reCadastral matches a cadastral number "AA:BB:CCCCCCC:KK": the cadastral okrug, the district,
+// the six or seven-digit quarter and the number of the object within the quarter.
+var reCadastral = regexp.MustCompile(`^([0-9]{2}):([0-9]{2}):([0-9]{6,7}):([0-9]+)$`)
+
+// reQuarter matches a cadastral quarter number.
+var reQuarter = regexp.MustCompile(`^[0-9]{6,7}$`)
+
+var (
+	// Cadastral validates a cadastral number of a real estate object: its format, the okrug code
+	// from 01 to 91 and the bounds of the district and the quarter within the okrug. Call Division
+	// to check the bounds against a loaded division and In to check the district against a directory
+	// of "AA:BB" codes.
+	Cadastral = &cadastralRule{division: cadastralDivision, code: 2980}
+
+	cadastralDivision, _ = ReadCadastralDivision(strings.NewReader(defaultCadastralDivision))
+)
+
+// CadastralNumber is the information encoded in a cadastral number.
+type CadastralNumber struct {
+	// Number is the cadastral number itself.
+	Number string
+	// Okrug is the two-digit code of the cadastral okrug.
+	Okrug string
+	// District is the two-digit code of the cadastral district within the okrug.
+	District string
+	// Quarter is the number of the cadastral quarter within the district.
+	Quarter string
+	// Object is the number of the object within the quarter.
+	Object string
+}
+
+// ParseCadastral parses a cadastral number and returns a non-zero code if the value is not valid.
+// The district and the quarter are checked against the bounds of the embedded division.
+func ParseCadastral(value interface{}) (info CadastralNumber, code int) {
+	info, code, _ = parseCadastral(value, cadastralDivision)
+	return
+}
+
+func parseCadastral(value interface{}, division *CadastralDivision) (info CadastralNumber, code int, args []interface{}) {
+	s, code := identifier(value, 2980)
+	if code != 0 {
+		return
+	}
+
+	m := reCadastral.FindStringSubmatch(s)
+	if m == nil {
+		code = 2980
+		return
+	}
+	if okrug, _ := strconv.Atoi(m[1]); okrug < 1 || okrug > maxCadastralOkrug {
+		code = 2981
+		return
+	}
+	if object, _ := strconv.ParseInt(m[4], 10, 64); object == 0 {
+		code = 2980
+		return
+	}
+	if code, args = division.check(m[1], m[2], m[3]); code != 0 {
+		return
+	}
+
+	info = CadastralNumber{Number: s, Okrug: m[1], District: m[2], Quarter: m[3], Object: m[4]}
+	return
+}
+
+type cadastralRule struct {
+	division *CadastralDivision
+	dir      *Directory
+	code     int
+}
+
+// In returns a copy of the rule that also checks that the "AA:BB" code of the okrug and the district
+// can be found in the directory.
+func (r *cadastralRule) In(dir *Directory) *cadastralRule {
+	return &cadastralRule{division: r.division, dir: dir, code: r.code}
+}
+
+// Division returns a copy of the rule that checks the bounds of the district and the quarter
+// against the division instead of the embedded one.
+func (r *cadastralRule) Division(division *CadastralDivision) *cadastralRule {
+	return &cadastralRule{division: division, dir: r.dir, code: r.code}
+}
+
+func (r *cadastralRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	info, code, args := parseCadastral(value, r.division)
+	if code != 0 {
+		return
+	}
+
+	if district := info.Okrug + ":" + info.District; r.dir != nil && !r.dir.Contains(district) {
+		code, args = 2982, []interface{}{district}
+	}
+	return
+}
+
+// CadastralDivision holds the bounds of the cadastral districts and quarters of the okrugs.
+type CadastralDivision struct {
+	okrugs map[string]cadastralBounds
+}
+
+// cadastralBounds are the last district code and the last quarter number of an okrug.
+type cadastralBounds struct {
+	district int
+	quarter  string
+}
+
+// LoadCadastralDivision loads the cadastral division from a local CSV file, see ReadCadastralDivision.
+func LoadCadastralDivision(path string) (*CadastralDivision, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadCadastralDivision(f)
+}
+
+// ReadCadastralDivision reads the cadastral division from semicolon separated CSV data. Every line holds
+// the okrug code, the last district code and the last quarter number of the okrug, e.g. "77;22;0999999".
+// The last quarter is written with as many digits as the quarters of the okrug have. Lines with
+// a non-numeric bound, such as a header, are skipped. The okrugs missing from the data are not bounded.
+func ReadCadastralDivision(r io.Reader) (*CadastralDivision, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &CadastralDivision{okrugs: make(map[string]cadastralBounds)}
+	for _, rec := range records {
+		if len(rec) < 3 {
+			continue
+		}
+		district, err := strconv.Atoi(strings.TrimSpace(rec[1]))
+		if err != nil {
+			continue
+		}
+		quarter := strings.TrimSpace(rec[2])
+		if !reQuarter.MatchString(quarter) {
+			continue
+		}
+		d.okrugs[strings.TrimSpace(rec[0])] = cadastralBounds{district: district, quarter: quarter}
+	}
+	return d, nil
+}
+
+// check returns a non-zero code if the district or the quarter is beyond the bounds of the okrug.
+func (d *CadastralDivision) check(okrug, district, quarter string) (code int, args []interface{}) {
+	if d == nil {
+		return
+	}
+	bounds, ok := d.okrugs[okrug]
+	if !ok {
+		return
+	}
+	if n, _ := strconv.Atoi(district); n > bounds.district {
+		return 2986, []interface{}{district, okrug}
+	}
+	if len(quarter) != len(bounds.quarter) || quarter > bounds.quarter {
+		return 2987, []interface{}{quarter, okrug}
+	}
+	return
+}
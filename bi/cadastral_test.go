@@ -0,0 +1,81 @@
+package bi
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestParseCadastral(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		info  CadastralNumber
+	}{
+		{"77:01:0001001:1024", 0, CadastralNumber{Number: "77:01:0001001:1024", Okrug: "77", District: "01", Quarter: "0001001", Object: "1024"}},
+		{"50:21:120101:5", 0, CadastralNumber{Number: "50:21:120101:5", Okrug: "50", District: "21", Quarter: "120101", Object: "5"}},
+		{"91:01:010101:10", 0, CadastralNumber{Number: "91:01:010101:10", Okrug: "91", District: "01", Quarter: "010101", Object: "10"}},
+		{"77:22:0020208:7", 0, CadastralNumber{Number: "77:22:0020208:7", Okrug: "77", District: "22", Quarter: "0020208", Object: "7"}},
+		{"77:23:0020208:7", 2986, CadastralNumber{}},
+		{"91:05:010101:10", 2986, CadastralNumber{}},
+		{"77:01:1001001:1024", 2987, CadastralNumber{}},
+		{"91:01:0010101:10", 2987, CadastralNumber{}},
+		{"90:25:0101001:10", 2987, CadastralNumber{}},
+		{"92:01:0010101:10", 2981, CadastralNumber{}},
+		{"00:01:0010101:10", 2981, CadastralNumber{}},
+		{"77:01:0001001:0", 2980, CadastralNumber{}},
+		{"77:01:00010:1024", 2980, CadastralNumber{}},
+		{"77:1:0001001:1024", 2980, CadastralNumber{}},
+		{"77-01-0001001-1024", 2980, CadastralNumber{}},
+		{"77:01:0001001", 2980, CadastralNumber{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParseCadastral(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+}
+
+func TestCadastral(t *testing.T) {
+	dir := NewDirectory("77:01", "50:21")
+	division, err := ReadCadastralDivision(strings.NewReader("okrug;district;quarter\n50;62;0999999\n38;36;999999\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"empty", Cadastral, "", 0},
+		{"valid", Cadastral, "77:01:0001001:1024", 0},
+		{"district of an unbounded okrug", Cadastral, "50:99:0001001:1024", 0},
+		{"district out of the okrug", Cadastral, "78:43:0001001:1024", 2986},
+		{"district of a loaded division", Cadastral.Division(division), "50:62:0120101:5", 0},
+		{"district out of a loaded division", Cadastral.Division(division), "50:63:0120101:5", 2986},
+		{"quarter out of a loaded division", Cadastral.Division(division), "50:21:1120101:5", 2987},
+		{"quarter length of a loaded division", Cadastral.Division(division), "38:36:0000034:5", 2987},
+		{"quarter of a loaded division", Cadastral.Division(division), "38:36:000034:5", 0},
+		{"okrug missing from a loaded division", Cadastral.Division(division), "77:99:0001001:1024", 0},
+		{"directory of a loaded division", Cadastral.Division(division).In(dir), "50:22:0120101:5", 2982},
+		{"district in the directory", Cadastral.In(dir), "50:21:120101:5", 0},
+		{"district not in the directory", Cadastral.In(dir), "50:22:120101:5", 2982},
+		{"invalid okrug with a directory", Cadastral.In(dir), "95:01:0001001:1024", 2981},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+
+	if _, args := Cadastral.Validate("78:43:0001001:1024"); !reflect.DeepEqual(args, []interface{}{"43", "78"}) {
+		t.Errorf("district args: got %v", args)
+	}
+}
@@ -0,0 +1,55 @@
+package bi
+
+import (
+	"regexp"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+var (
+	// OKTMO validates an 8 or 11-digit code of the All-Russian classifier of municipal territories.
+	OKTMO = &classifierRule{re: regexp.MustCompile(`^[0-9]{8}([0-9]{3})?$`), lengths: []int{8, 11}, code: 2940}
+	// OKVED validates a code of the All-Russian classifier of economic activities (OKVED2):
+	// a class "NN", a subclass "NN.N", a group "NN.NN", a subgroup "NN.NN.N" or a type "NN.NN.NN".
+	OKVED = &classifierRule{re: regexp.MustCompile(`^[0-9]{2}(\.[0-9]([0-9](\.[0-9]{1,2})?)?)?$`), code: 2941}
+	// OKOPF validates a 5-digit code of the All-Russian classifier of organizational and legal forms.
+	OKOPF = &classifierRule{re: regexp.MustCompile(`^[0-9]{5}$`), lengths: []int{5}, code: 2942}
+	// OKFS validates a 2-digit code of the All-Russian classifier of forms of ownership.
+	OKFS = &classifierRule{re: regexp.MustCompile(`^[0-9]{2}$`), lengths: []int{2}, code: 2943}
+	// OKOGU validates a 7-digit code of the All-Russian classifier of public authorities.
+	OKOGU = &classifierRule{re: regexp.MustCompile(`^[0-9]{7}$`), lengths: []int{7}, code: 2944}
+)
+
+type classifierRule struct {
+	re      *regexp.Regexp
+	lengths []int
+	dir     *Directory
+	code    int
+}
+
+// In returns a copy of the rule that also checks that the code can be found in the directory,
+// so that a well-formed but non-existent code is rejected.
+func (r *classifierRule) In(dir *Directory) *classifierRule {
+	return &classifierRule{re: r.re, lengths: r.lengths, dir: dir, code: r.code}
+}
+
+func (r *classifierRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code, r.lengths...)
+	if code != 0 {
+		return
+	}
+	if !r.re.MatchString(s) {
+		code = r.code
+		return
+	}
+
+	if r.dir != nil && !r.dir.Contains(s) {
+		code, args = 2945, []interface{}{s}
+	}
+	return
+}
@@ -0,0 +1,111 @@
+package bi
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestClassifiers(t *testing.T) {
+	dir := NewDirectory("62.01", " 62.02 ", "")
+
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"OKTMO empty", OKTMO, "", 0},
+		{"OKTMO 8", OKTMO, "45382000", 0},
+		{"OKTMO 11", OKTMO, "45382000001", 0},
+		{"OKTMO 9", OKTMO, "453820001", 2940},
+		{"OKTMO lost zero", OKTMO, 4538200, 2809},
+		{"OKVED class", OKVED, "62", 0},
+		{"OKVED subclass", OKVED, "62.0", 0},
+		{"OKVED group", OKVED, "62.01", 0},
+		{"OKVED subgroup", OKVED, "47.11.1", 0},
+		{"OKVED type", OKVED, "47.11.11", 0},
+		{"OKVED three digits", OKVED, "47.111", 2941},
+		{"OKVED one digit", OKVED, "6", 2941},
+		{"OKOPF", OKOPF, "12300", 0},
+		{"OKOPF short", OKOPF, "1230", 2942},
+		{"OKFS", OKFS, "16", 0},
+		{"OKFS long", OKFS, "160", 2943},
+		{"OKOGU", OKOGU, "4210011", 0},
+		{"OKOGU short", OKOGU, "421001", 2944},
+		{"in directory", OKVED.In(dir), "62.02", 0},
+		{"not in directory", OKVED.In(dir), "62.03", 2945},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+
+	if dir.Len() != 2 {
+		t.Errorf("Len: got %v, want 2", dir.Len())
+	}
+	if code, _ := OKVED.Validate("62.03"); code != 0 {
+		t.Errorf("In must not change the original rule: got %v", code)
+	}
+}
+
+func TestReadDirectory(t *testing.T) {
+	tests := []struct {
+		tag  string
+		json bool
+		data string
+	}{
+		{"csv", false, "code,name\n12300,A\n12200,B\n"},
+		{"semicolon", false, "code;name\n12300;\"A; B\"\n12200;C\n"},
+		{"json codes", true, `["12300", "12200"]`},
+		{"json objects", true, `[{"code": "12300", "name": "A"}, {"code": "12200"}]`},
+	}
+
+	for _, test := range tests {
+		read := ReadDirectoryCSV
+		if test.json {
+			read = ReadDirectoryJSON
+		}
+		d, err := read(strings.NewReader(test.data))
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", test.tag, err)
+			continue
+		}
+		if !d.Contains("12300") || !d.Contains("12200") || d.Contains("12100") {
+			t.Errorf("%s: got %v", test.tag, d.codes)
+		}
+	}
+
+	if _, err := ReadDirectoryJSON(strings.NewReader(`[1]`)); err == nil {
+		t.Error("json number: expected an error")
+	}
+}
+
+func TestLoadDirectory(t *testing.T) {
+	tmp := t.TempDir()
+	files := map[string]string{"okfs.csv": "16\n41\n", "okfs.JSON": `["16", "41"]`}
+
+	for name, content := range files {
+		path := filepath.Join(tmp, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		d, err := LoadDirectory(path)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		if d.Len() != 2 || !d.Contains("41") {
+			t.Errorf("%s: got %v", name, d.codes)
+		}
+	}
+
+	if _, err := LoadDirectory(filepath.Join(tmp, "missing.csv")); err == nil {
+		t.Error("missing file: expected an error")
+	}
+}
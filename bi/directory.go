@@ -0,0 +1,111 @@
+package bi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Directory is a set of codes loaded from an official classifier list.
+type Directory struct {
+	codes map[string]struct{}
+}
+
+// NewDirectory returns a directory that contains the given codes.
+func NewDirectory(codes ...string) *Directory {
+	d := &Directory{codes: make(map[string]struct{}, len(codes))}
+	for _, c := range codes {
+		if c = strings.TrimSpace(c); c != "" {
+			d.codes[c] = struct{}{}
+		}
+	}
+	return d
+}
+
+// Contains checks if the code can be found in the directory.
+func (d *Directory) Contains(code string) bool {
+	_, ok := d.codes[code]
+	return ok
+}
+
+// Len returns the number of codes in the directory.
+func (d *Directory) Len() int {
+	return len(d.codes)
+}
+
+// LoadDirectory loads a directory from a local file. Files with the .json extension are read
+// with ReadDirectoryJSON, all other files with ReadDirectoryCSV.
+func LoadDirectory(path string) (*Directory, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return ReadDirectoryJSON(f)
+	}
+	return ReadDirectoryCSV(f)
+}
+
+// ReadDirectoryCSV reads a directory from CSV data, taking the codes from the first column.
+// Both comma and semicolon separated files are accepted, a header line is harmless
+// since it never matches a code.
+func ReadDirectoryCSV(r io.Reader) (*Directory, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	if line, _ := bufio.NewReader(bytes.NewReader(data)).ReadString('\n'); strings.Contains(line, ";") {
+		reader.Comma = ';'
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, len(records))
+	for _, rec := range records {
+		if len(rec) > 0 {
+			codes = append(codes, rec[0])
+		}
+	}
+	return NewDirectory(codes...), nil
+}
+
+// ReadDirectoryJSON reads a directory from JSON data: either an array of codes,
+// or an array of objects whose "code" field holds the code.
+func ReadDirectoryJSON(r io.Reader) (*Directory, error) {
+	var items []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, len(items))
+	for _, item := range items {
+		var code string
+		if err := json.Unmarshal(item, &code); err == nil {
+			codes = append(codes, code)
+			continue
+		}
+		var obj struct {
+			Code string `json:"code"`
+		}
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return nil, err
+		}
+		codes = append(codes, obj.Code)
+	}
+	return NewDirectory(codes...), nil
+}
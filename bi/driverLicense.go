@@ -0,0 +1,10 @@
+package bi
+
+import "regexp"
+
+// reDriverLicense matches the series and the number of a driver's license: the current four-digit series
+// or the older series of two digits and two Cyrillic letters, followed by a six-digit number.
+var reDriverLicense = regexp.MustCompile(`^[0-9]{2} ?([0-9]{2}|[АВЕКМНОРСТУХ]{2}) ?[0-9]{6}$`)
+
+// DriverLicense validates the series and the number of a Russian driver's license, e.g. "77 12 345678" or "77 АВ 345678".
+var DriverLicense = &formatRule{re: reDriverLicense, lengths: []int{10}, code: 2952}
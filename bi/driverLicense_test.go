@@ -0,0 +1,24 @@
+package bi
+
+import "testing"
+
+func TestDriverLicense(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"77 12 345678", 0},
+		{"7712345678", 0},
+		{"77 АВ 345678", 0},
+		{"77 12 34567", 2952},
+		{"77 AB 345678", 2952},
+		{"77 АБ 345678", 2952},
+	}
+
+	for _, test := range tests {
+		if code, _ := DriverLicense.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package bi
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// reRegion matches a two-digit region code.
+var reRegion = regexp.MustCompile(`^[0-9]{2}$`)
+
+// The registration years an OGRN can encode: the register started in 2002 and the year is written with two digits.
+const (
+	minOGRNYear = 2002
+	maxOGRNYear = 2099
+)
+
+// Generator produces random identifiers with valid control sums, e.g. for test fixtures.
+// A Generator is not safe for concurrent use.
+type Generator struct {
+	rnd      *rand.Rand
+	now      func() time.Time
+	region   string
+	year     int
+	nearMiss bool
+}
+
+// NewGenerator returns a generator seeded with the given value, so that the same seed produces the same identifiers.
+// The registration years are picked from 2002 up to the current one, call Clock or Year to keep them the same over time.
+func NewGenerator(seed int64) *Generator {
+	return &Generator{rnd: rand.New(rand.NewSource(seed)), now: time.Now}
+}
+
+// Clock sets the function that returns the current time, it is time.Now by default.
+func (g *Generator) Clock(now func() time.Time) *Generator {
+	g.now = now
+
+	return g
+}
+
+// Region fixes the two-digit region code of the generated INN, OGRN, OGRNIP and OKATO values.
+// It panics if the region is not a two-digit code.
+func (g *Generator) Region(region string) *Generator {
+	if !reRegion.MatchString(region) {
+		panic(fmt.Sprintf("bi: invalid region code %q", region))
+	}
+	g.region = region
+
+	return g
+}
+
+// Year fixes the registration year of the generated OGRN and OGRNIP values.
+// It panics if the year is not between 2002 and 2099.
+func (g *Generator) Year(year int) *Generator {
+	if year < minOGRNYear || year > maxOGRNYear {
+		panic(fmt.Sprintf("bi: invalid registration year %d", year))
+	}
+	g.year = year
+
+	return g
+}
+
+// NearMiss makes the generator produce well-formed values with a broken control sum, for negative tests.
+func (g *Generator) NearMiss() *Generator {
+	g.nearMiss = true
+
+	return g
+}
+
+// Inn10 returns a 10-digit INN of a legal entity.
+func (g *Generator) Inn10() string {
+	s := g.regionCode() + g.digits(7)
+	s += g.control(innControl(s, inn10Coefficients))
+
+	return s
+}
+
+// Inn12 returns a 12-digit INN of an individual.
+func (g *Generator) Inn12() string {
+	s := g.regionCode() + g.digits(8)
+	s += strconv.FormatInt(innControl(s, inn12Coefficients11), 10)
+	s += g.control(innControl(s, inn12Coefficients12))
+
+	return s
+}
+
+// OGRN returns a 13-digit OGRN of a legal entity.
+func (g *Generator) OGRN() string {
+	s := []string{"1", "5"}[g.rnd.Intn(2)] + g.yearCode() + g.regionCode() + g.digits(7)
+	body, _ := strconv.ParseInt(s, 10, 64)
+	s += g.control(body % 11 % 10)
+
+	return s
+}
+
+// OGRNIP returns a 15-digit OGRNIP of an individual entrepreneur.
+func (g *Generator) OGRNIP() string {
+	s := "3" + g.yearCode() + g.regionCode() + g.digits(9)
+	body, _ := strconv.ParseInt(s, 10, 64)
+	s += g.control(body % 13 % 10)
+
+	return s
+}
+
+// SNILS returns an 11-digit SNILS without separators.
+func (g *Generator) SNILS() string {
+	number := snilsMinChecked + 1 + g.rnd.Int63n(999999999-snilsMinChecked)
+	s := fmt.Sprintf("%09d", number)
+	control := snilsSum(s)
+	if g.nearMiss {
+		control = (control + 1 + g.rnd.Int63n(99)) % 101 % 100
+	}
+
+	return s + fmt.Sprintf("%02d", control)
+}
+
+// OKPO8 returns an 8-digit OKPO of a legal entity.
+func (g *Generator) OKPO8() string {
+	return g.okpo(g.digits(7))
+}
+
+// OKPO10 returns a 10-digit OKPO of an individual entrepreneur.
+func (g *Generator) OKPO10() string {
+	return g.okpo(g.digits(9))
+}
+
+// OKATO returns an 11-digit OKATO code including the control digit.
+func (g *Generator) OKATO() string {
+	return g.okpo(g.regionCode() + g.digits(8))
+}
+
+func (g *Generator) okpo(s string) string {
+	return s + g.control(okpoControl(s))
+}
+
+// control returns the control digit, or a different digit in the near miss mode.
+func (g *Generator) control(digit int64) string {
+	if g.nearMiss {
+		digit = (digit + 1 + g.rnd.Int63n(9)) % 10
+	}
+	return strconv.FormatInt(digit, 10)
+}
+
+func (g *Generator) regionCode() string {
+	if g.region != "" {
+		return g.region
+	}
+	return fmt.Sprintf("%02d", 1+g.rnd.Intn(99))
+}
+
+func (g *Generator) yearCode() string {
+	year := g.year
+	if year == 0 {
+		last := g.now().Year()
+		if last < minOGRNYear {
+			last = minOGRNYear
+		} else if last > maxOGRNYear {
+			last = maxOGRNYear
+		}
+		year = minOGRNYear + g.rnd.Intn(last-minOGRNYear+1)
+	}
+	return fmt.Sprintf("%02d", year%100)
+}
+
+func (g *Generator) digits(n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteByte(byte('0' + g.rnd.Intn(10)))
+	}
+	return b.String()
+}
@@ -0,0 +1,122 @@
+package bi
+
+import (
+	"testing"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func fixedClock() time.Time {
+	return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGenerator(t *testing.T) {
+	tests := []struct {
+		tag      string
+		generate func(g *Generator) string
+		rule     validation.Rule
+		code     int
+	}{
+		{"Inn10", (*Generator).Inn10, Inn10, 2812},
+		{"Inn12", (*Generator).Inn12, Inn12, 2823},
+		{"OGRN", (*Generator).OGRN, OGRNLaw, 2842},
+		{"OGRNIP", (*Generator).OGRNIP, OPGNIp, 2852},
+		{"SNILS", (*Generator).SNILS, Snils, 2880},
+		{"OKPO8", (*Generator).OKPO8, OkatoOkpo, 2870},
+		{"OKPO10", (*Generator).OKPO10, OkatoOkpo, 2870},
+		{"OKATO", (*Generator).OKATO, OkatoOkpo, 2870},
+	}
+
+	for _, test := range tests {
+		valid := NewGenerator(1).Clock(fixedClock)
+		nearMiss := NewGenerator(1).Clock(fixedClock).NearMiss()
+		for i := 0; i < 200; i++ {
+			if s := test.generate(valid); !isValid(test.rule, s) {
+				t.Errorf("%s: %s is not valid", test.tag, s)
+			}
+			if s := test.generate(nearMiss); validationCode(test.rule, s) != test.code {
+				t.Errorf("%s: near miss %s got %v, want %v", test.tag, s, validationCode(test.rule, s), test.code)
+			}
+		}
+	}
+}
+
+func TestGeneratorIsReproducible(t *testing.T) {
+	a := NewGenerator(42).Clock(fixedClock)
+	b := NewGenerator(42).Clock(fixedClock)
+	for i := 0; i < 20; i++ {
+		if x, y := a.OGRN(), b.OGRN(); x != y {
+			t.Fatalf("the same seed produced %s and %s", x, y)
+		}
+	}
+}
+
+func TestGeneratorOptions(t *testing.T) {
+	g := NewGenerator(7).Clock(fixedClock).Region("77").Year(2015)
+	for i := 0; i < 20; i++ {
+		inn, _ := ParseINN(g.Inn10())
+		if inn.Region != "77" {
+			t.Errorf("Inn10 region: got %s", inn.Region)
+		}
+		ogrn, _ := ParseOGRN(g.OGRNIP())
+		if ogrn.Region != "77" || ogrn.Year != 2015 {
+			t.Errorf("OGRNIP region and year: got %s %d", ogrn.Region, ogrn.Year)
+		}
+	}
+
+	g = NewGenerator(7).Clock(fixedClock)
+	for i := 0; i < 100; i++ {
+		if ogrn, _ := ParseOGRN(g.OGRN()); ogrn.Year < 2002 || ogrn.Year > 2024 {
+			t.Errorf("OGRN year is out of the clock range: %d", ogrn.Year)
+		}
+	}
+}
+
+func TestGeneratorRegionPanics(t *testing.T) {
+	for _, region := range []string{"", "7", "777", "7A"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Region(%q) did not panic", region)
+				}
+			}()
+			NewGenerator(1).Region(region)
+		}()
+	}
+}
+
+func TestGeneratorYear(t *testing.T) {
+	for _, year := range []int{0, 1990, 2001, 2100, 2150} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Year(%d) did not panic", year)
+				}
+			}()
+			NewGenerator(1).Year(year)
+		}()
+	}
+
+	clocks := map[string]func() time.Time{
+		"past":   func() time.Time { return time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC) },
+		"future": func() time.Time { return time.Date(2150, 1, 1, 0, 0, 0, 0, time.UTC) },
+	}
+	for tag, clock := range clocks {
+		g := NewGenerator(3).Clock(clock)
+		for i := 0; i < 20; i++ {
+			if ogrn, code := ParseOGRN(g.OGRN()); code != 0 || ogrn.Year < 2002 || ogrn.Year > 2099 {
+				t.Errorf("%s: OGRN year is out of range: %d %v", tag, ogrn.Year, code)
+			}
+		}
+	}
+}
+
+func isValid(rule validation.Rule, value interface{}) bool {
+	return validationCode(rule, value) == 0
+}
+
+func validationCode(rule validation.Rule, value interface{}) int {
+	code, _ := rule.Validate(value)
+	return code
+}
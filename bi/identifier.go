@@ -0,0 +1,152 @@
+package bi
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// separators are the characters removed from an identifier before it is validated by default,
+// so that formatted values such as "123-456-789 01" are accepted.
+const separators = " \u00a0-"
+
+// WithSeparators returns a rule that removes the given separators from an identifier instead of
+// the default ones, a space, a no-break space and a hyphen, before validating it with the rule.
+// Pass "" to accept unformatted identifiers only, e.g. WithSeparators("", Snils).
+// It applies to the identifier rules of bi and of its sub-packages, not to FIASGUID.
+func WithSeparators(separators string, rule validation.Rule) validation.Rule {
+	return &separatorsRule{separators: separators, rule: rule}
+}
+
+type separatorsRule struct {
+	separators string
+	rule       validation.Rule
+}
+
+func (r *separatorsRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+	return r.rule.Validate(separated{value: value, separators: r.separators})
+}
+
+// separated is an identifier passed to a rule along with the separators to remove from it.
+type separated struct {
+	value      interface{}
+	separators string
+}
+
+// Identifier converts an identifier into a string and removes the separators from it, for the rules
+// of identifiers of other countries in the sub-packages of bi.
+func Identifier(value interface{}, typeCode int, lengths ...int) (string, int) {
+	return identifier(value, typeCode, lengths...)
+}
+
+// identifier converts an identifier into a string and removes the separators from it.
+// It accepts strings, byte slices, integers of any kind, fmt.Stringer and driver.Valuer, e.g. json.Number
+// and sql.NullString. The typeCode is returned if the value is of an unsupported type. An integer that
+// would match one of the lengths only with leading zeros is rejected, since it has lost them.
+func identifier(value interface{}, typeCode int, lengths ...int) (string, int) {
+	seps := separators
+	if v, ok := value.(separated); ok {
+		value, seps = v.value, v.separators
+	}
+
+	s, numeric, ok := identifierText(value)
+	if !ok {
+		return "", typeCode
+	}
+
+	if numeric {
+		lostZeros := false
+		for _, l := range lengths {
+			if len(s) == l {
+				return s, 0
+			}
+			lostZeros = lostZeros || len(s) < l
+		}
+		if lostZeros {
+			return "", 2809
+		}
+		return s, 0
+	}
+
+	if s = stripSeparators(s, seps); s == "" {
+		return "", typeCode
+	}
+	return s, 0
+}
+
+// identifierText converts an identifier into a string as is.
+func identifierText(value interface{}) (s string, numeric bool, ok bool) {
+	if v, ok := value.(separated); ok {
+		value = v.value
+	}
+	value, isNil := validation.Indirect(value)
+	if isNil {
+		return "", false, false
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), false, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return "", false, false
+		}
+		return strconv.FormatInt(rv.Int(), 10), true, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), true, true
+	case reflect.Slice:
+		if b, ok := value.([]byte); ok {
+			return string(b), false, true
+		}
+	}
+
+	if str, ok := value.(fmt.Stringer); ok {
+		return str.String(), false, true
+	}
+
+	return "", false, false
+}
+
+func stripSeparators(s, separators string) string {
+	if separators == "" {
+		return s
+	}
+	return strings.Map(func(c rune) rune {
+		if strings.ContainsRune(separators, c) {
+			return -1
+		}
+		return c
+	}, s)
+}
+
+// formatRule validates an identifier against a regular expression after removing the separators from it.
+type formatRule struct {
+	re      *regexp.Regexp
+	lengths []int
+	code    int
+}
+
+func (r *formatRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code, r.lengths...)
+	if code != 0 {
+		return
+	}
+	if !r.re.MatchString(s) {
+		code = r.code
+	}
+	return
+}
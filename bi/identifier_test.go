@@ -0,0 +1,71 @@
+package bi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+)
+
+type identifierTestStringer string
+
+func (s identifierTestStringer) String() string { return string(s) }
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		tag     string
+		value   interface{}
+		lengths []int
+		want    string
+		code    int
+	}{
+		{"string", "7707083893", []int{10}, "7707083893", 0},
+		{"separators", "123-456-789 01", []int{11}, "12345678901", 0},
+		{"bytes", []byte("77 07 083893"), []int{10}, "7707083893", 0},
+		{"int", 7707083893, []int{10}, "7707083893", 0},
+		{"uint64", uint64(7707083893), []int{10}, "7707083893", 0},
+		{"int8", int8(12), []int{2}, "12", 0},
+		{"lost zero", 707083893, []int{10}, "", 2809},
+		{"lost zero of the shorter length", 7083893, []int{8, 10}, "", 2809},
+		{"longer than every length", 77070838930, []int{10}, "77070838930", 0},
+		{"negative", -7707083893, []int{10}, "", 100},
+		{"json number", json.Number("7707083893"), []int{10}, "7707083893", 0},
+		{"sql null string", sql.NullString{String: "7707083893", Valid: true}, []int{10}, "7707083893", 0},
+		{"stringer", identifierTestStringer("7707-083893"), []int{10}, "7707083893", 0},
+		{"pointer", &[]string{"7707083893"}[0], []int{10}, "7707083893", 0},
+		{"separators only", " - ", []int{10}, "", 100},
+		{"float", 1.5, []int{10}, "", 100},
+		{"custom separators", separated{value: "7707.083893", separators: "."}, []int{10}, "7707083893", 0},
+		{"no separators", separated{value: "7707 083893", separators: ""}, []int{10}, "7707 083893", 0},
+	}
+
+	for _, test := range tests {
+		s, code := identifier(test.value, 100, test.lengths...)
+		if s != test.want || code != test.code {
+			t.Errorf("%s: got %q %v, want %q %v", test.tag, s, code, test.want, test.code)
+		}
+	}
+}
+
+func TestWithSeparators(t *testing.T) {
+	tests := []struct {
+		tag        string
+		separators string
+		value      interface{}
+		code       int
+	}{
+		{"empty", "", "", 0},
+		{"digits only", "", "11223344595", 0},
+		{"formatted", "", "112-233-445 95", 1502},
+		{"dots", ".", "112.233.445.95", 0},
+		{"hyphens are not separators", ".", "112-233-445-95", 1502},
+	}
+
+	for _, test := range tests {
+		if code, _ := WithSeparators(test.separators, Snils).Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+	if code, _ := Snils.Validate("112-233-445 95"); code != 0 {
+		t.Errorf("default separators: got %v", code)
+	}
+}
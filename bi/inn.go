@@ -0,0 +1,105 @@
+package bi
+
+import (
+	"unicode/utf8"
+
+	"github.com/cadyrov/govalidation/checksum"
+	"github.com/cadyrov/govalidation/is"
+)
+
+const (
+	// INNLegal is the kind of a 10-digit INN that belongs to a legal entity.
+	INNLegal INNKind = iota + 1
+	// INNPerson is the kind of a 12-digit INN that belongs to an individual or an individual entrepreneur.
+	INNPerson
+)
+
+// INNKind is the kind of the taxpayer an INN belongs to.
+type INNKind int
+
+// INN is the information encoded in a taxpayer identification number.
+type INN struct {
+	// Number is the INN itself.
+	Number string
+	// Kind tells whether the INN belongs to a legal entity or to an individual.
+	Kind INNKind
+	// Region is the two-digit code of the region where the INN was assigned.
+	Region string
+	// TaxOffice is the four-digit code of the tax office that assigned the INN, including the region.
+	TaxOffice string
+	// Record is the record number within the tax office.
+	Record string
+}
+
+// ParseINN parses a 10-digit INN of a legal entity or a 12-digit INN of an individual.
+// It returns a non-zero code if the value is not a valid INN.
+func ParseINN(value interface{}) (info INN, code int) {
+	s, code := identifier(value, 2830, 10, 12)
+	if code != 0 {
+		return
+	}
+
+	switch utf8.RuneCountInString(s) {
+	case 10:
+		return parseInn10(s)
+	case 12:
+		return parseInn12(s)
+	}
+
+	code = 2830
+	return
+}
+
+func parseInn10(s string) (info INN, code int) {
+	if code, _ = is.Digit.Validate(s); code != 0 {
+		return
+	}
+
+	if utf8.RuneCountInString(s) != 10 {
+		code = 2811
+		return
+	}
+
+	if !checkInnControl(s, inn10Coefficients) {
+		code = 2812
+		return
+	}
+
+	info = INN{Number: s, Kind: INNLegal, Region: s[:2], TaxOffice: s[:4], Record: s[4:9]}
+	return
+}
+
+func parseInn12(s string) (info INN, code int) {
+	if code, _ = is.Digit.Validate(s); code != 0 {
+		return
+	}
+
+	if utf8.RuneCountInString(s) != 12 {
+		code = 2822
+		return
+	}
+
+	if !checkInnControl(s, inn12Coefficients11) || !checkInnControl(s, inn12Coefficients12) {
+		code = 2823
+		return
+	}
+
+	info = INN{Number: s, Kind: INNPerson, Region: s[:2], TaxOffice: s[:4], Record: s[4:10]}
+	return
+}
+
+var (
+	inn10Coefficients   = checksum.Weighted{2, 4, 10, 3, 5, 9, 4, 6, 8}
+	inn12Coefficients11 = checksum.Weighted{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+	inn12Coefficients12 = checksum.Weighted{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+)
+
+// innControl calculates the control digit for the digits of the INN preceding it.
+func innControl(inn string, coefficients checksum.Weighted) int64 {
+	return int64(coefficients.Mod(inn[:len(coefficients)], 11) % 10)
+}
+
+// checkInnControl checks the control digit that follows the weighted digits of the INN.
+func checkInnControl(inn string, coefficients checksum.Weighted) bool {
+	return innControl(inn, coefficients) == int64(inn[len(coefficients)]-'0')
+}
@@ -1,11 +1,7 @@
 package bi
 
 import (
-	"strconv"
-	"unicode/utf8"
-
 	validation "github.com/cadyrov/govalidation"
-	"github.com/cadyrov/govalidation/is"
 )
 
 var Inn10 = &inn10Rule{code: 2810}
@@ -19,33 +15,11 @@ func (inn *inn10Rule) Validate(value interface{}) (code int, args []interface{})
 	if isNil || validation.IsEmpty(value) {
 		return
 	}
-	var s string
-	switch value.(type) {
-	case string:
-		s = value.(string)
-	case int:
-		s = strconv.Itoa(value.(int))
-	case int64:
-		s = strconv.FormatInt(value.(int64), 10)
-	default:
-		code = inn.code
-		return
-	}
-
-	if code, args = is.Digit.Validate(s); code != 0 {
-		return
-	}
-
-	if utf8.RuneCountInString(s) != 10 {
-		code = 2811
+	s, code := identifier(value, inn.code, 10)
+	if code != 0 {
 		return
 	}
 
-	coefficients := []int64{2, 4, 10, 3, 5, 9, 4, 6, 8}
-
-	if !checkInnDigits(s, coefficients) {
-		code = 2812
-		return
-	}
+	_, code = parseInn10(s)
 	return
 }
@@ -1,11 +1,7 @@
 package bi
 
 import (
-	"strconv"
-	"unicode/utf8"
-
 	validation "github.com/cadyrov/govalidation"
-	"github.com/cadyrov/govalidation/is"
 )
 
 var Inn12 = &inn12Rule{code: 2820}
@@ -19,34 +15,12 @@ func (inn *inn12Rule) Validate(value interface{}) (code int, args []interface{})
 	if isNil || validation.IsEmpty(value) {
 		return
 	}
-	var s string
-	switch value.(type) {
-	case string:
-		s = value.(string)
-	case int:
-		s = strconv.Itoa(value.(int))
-	case int64:
-		s = strconv.FormatInt(value.(int64), 10)
-	default:
-		code = 2821
+	s, code := identifier(value, 2821, 12)
+	if code != 0 {
 		return
 	}
 
-	if code, args = is.Digit.Validate(s); code != 0 {
-		return
-	}
-
-	if utf8.RuneCountInString(s) != 12 {
-		code = 2822
-		return
-	}
-
-	coefficients11 := []int64{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
-	coefficients12 := []int64{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
-	if !checkInnDigits(s, coefficients11) || !checkInnDigits(s, coefficients12) {
-		code = 2823
-		return
-	}
+	_, code = parseInn12(s)
 	return
 }
 
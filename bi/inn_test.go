@@ -0,0 +1,70 @@
+package bi
+
+import (
+	"encoding/json"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestParseINN(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		info  INN
+	}{
+		{"7707083893", 0, INN{Number: "7707083893", Kind: INNLegal, Region: "77", TaxOffice: "7707", Record: "08389"}},
+		{"7736207543", 0, INN{Number: "7736207543", Kind: INNLegal, Region: "77", TaxOffice: "7736", Record: "20754"}},
+		{"500100732259", 0, INN{Number: "500100732259", Kind: INNPerson, Region: "50", TaxOffice: "5001", Record: "007322"}},
+		{"7707 083 893", 0, INN{Number: "7707083893", Kind: INNLegal, Region: "77", TaxOffice: "7707", Record: "08389"}},
+		{int64(7707083893), 0, INN{Number: "7707083893", Kind: INNLegal, Region: "77", TaxOffice: "7707", Record: "08389"}},
+		{"7707083894", 2812, INN{}},
+		{"7707083883", 2812, INN{}},
+		{"500100732258", 2823, INN{}},
+		{"500100732249", 2823, INN{}},
+		{"770708389", 2830, INN{}},
+		{"77070838931", 2830, INN{}},
+		{"77070838AB", 1502, INN{}},
+		{3.5, 2830, INN{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParseINN(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+}
+
+func TestInnRules(t *testing.T) {
+	var nilInn *string
+
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"Inn10 empty", Inn10, "", 0},
+		{"Inn10 nil", Inn10, nilInn, 0},
+		{"Inn10", Inn10, "7707083893", 0},
+		{"Inn10 json.Number", Inn10, json.Number("7736207543"), 0},
+		{"Inn10 one digit off", Inn10, "7707083892", 2812},
+		{"Inn10 12 digits", Inn10, "500100732259", 2811},
+		{"Inn10 letters", Inn10, "77070838XX", 1502},
+		{"Inn12", Inn12, "500100732259", 0},
+		{"Inn12 uint64", Inn12, uint64(500100732259), 0},
+		{"Inn12 one digit off", Inn12, "500100732250", 2823},
+		{"Inn12 10 digits", Inn12, "7707083893", 2822},
+		{"Inn12 lost zeros", Inn12, 12345678901, 2809},
+		{"Inn1012 legal", Inn1012, "7707083893", 0},
+		{"Inn1012 person", Inn1012, "500100732259", 0},
+		{"Inn1012 one digit off", Inn1012, "500100732269", 2803},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
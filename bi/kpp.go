@@ -0,0 +1,141 @@
+package bi
+
+import (
+	"regexp"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+const (
+	// maxRussianReason is the last KPP reason code of a Russian organization, the following ones are used
+	// for foreign organizations.
+	maxRussianReason = "50"
+	// largestTaxpayersRegion is the code the tax offices for the largest taxpayers start with, they are not
+	// bound to the region of the organization.
+	largestTaxpayersRegion = "99"
+)
+
+var (
+	reKpp = regexp.MustCompile(`^[0-9]{4}[0-9A-Z]{2}[0-9]{3}$`)
+
+	// KPP validates a tax registration reason code: four digits of the tax office, two digits or
+	// capital Latin letters of the reason and three digits of the sequence number.
+	KPP = &kppRule{code: 2890}
+)
+
+// KPPInfo is the information encoded in a tax registration reason code.
+type KPPInfo struct {
+	// Number is the KPP itself.
+	Number string
+	// TaxOffice is the four-digit code of the tax office the organization is registered with.
+	TaxOffice string
+	// Region is the two-digit code of the region of the tax office.
+	Region string
+	// Reason is the two-character registration reason.
+	Reason string
+	// Sequence is the sequence number of the registration for the same reason.
+	Sequence string
+}
+
+// ParseKPP parses a 9-character KPP and returns a non-zero code if the value is not a valid KPP.
+func ParseKPP(value interface{}) (info KPPInfo, code int) {
+	s, code := identifier(value, 2890, 9)
+	if code != 0 {
+		return
+	}
+	if !reKpp.MatchString(s) {
+		code = 2890
+		return
+	}
+
+	if s[4:6] == "00" {
+		code = 2891
+		return
+	}
+
+	info = KPPInfo{Number: s, TaxOffice: s[:4], Region: s[:2], Reason: s[4:6], Sequence: s[6:]}
+	return
+}
+
+type kppRule struct {
+	code int
+}
+
+func (r *kppRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	_, code = ParseKPP(value)
+	return
+}
+
+// KPPOfINN returns a rule that checks a KPP against the 10-digit INN of the same organization:
+// the INN must be valid and the KPP of a Russian organization (reason codes 01 to 50) must be issued
+// in the same region as the INN, unless it is issued by a tax office for the largest taxpayers (99XX).
+// Pass a pointer to the INN field so that its current value is used, e.g.
+//
+//	validation.Field(&c.KPP, bi.KPP, bi.KPPOfINN(&c.INN))
+//
+// Use TaxRequisites to check the INN and the KPP of a struct together.
+func KPPOfINN(inn interface{}) *kppInnRule {
+	return &kppInnRule{inn: inn, code: 2892}
+}
+
+type kppInnRule struct {
+	inn  interface{}
+	code int
+}
+
+func (r *kppInnRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	kpp, code := ParseKPP(value)
+	if code != 0 {
+		return
+	}
+
+	inn, isNil := validation.Indirect(r.inn)
+	if isNil || validation.IsEmpty(inn) {
+		return
+	}
+	if c, _ := Inn10.Validate(inn); c != 0 {
+		code = 2893
+		return
+	}
+
+	info, _ := ParseINN(inn)
+	if isRussianReason(kpp.Reason) && kpp.Region != largestTaxpayersRegion && kpp.Region != info.Region {
+		code, args = r.code, []interface{}{info.Region}
+	}
+	return
+}
+
+// isRussianReason checks if the KPP reason code is the one of a Russian organization.
+func isRussianReason(reason string) bool {
+	return reason[0] >= '0' && reason[0] <= '9' && reason[1] >= '0' && reason[1] <= '9' && reason <= maxRussianReason
+}
+
+// TaxRequisites is a struct-level validator for the tax identifiers of a Russian taxpayer.
+// Embed it or call Validate directly to check the KPP against the INN.
+type TaxRequisites struct {
+	INN string `json:"inn"`
+	KPP string `json:"kpp"`
+}
+
+// Validate checks the INN and the KPP together: an organization with a KPP must have a 10-digit INN
+// issued in the same region, see KPPOfINN. Without a KPP the INN may also be a 12-digit one of an individual.
+// Empty fields are not checked, use validation.Required on them to make sure they are set.
+func (r TaxRequisites) Validate() (code int, args []interface{}) {
+	if r.KPP == "" {
+		return Inn1012.Validate(r.INN)
+	}
+	if code, args = KPP.Validate(r.KPP); code != 0 {
+		return
+	}
+	return KPPOfINN(r.INN).Validate(r.KPP)
+}
@@ -0,0 +1,88 @@
+package bi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseKPP(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		info  KPPInfo
+	}{
+		{"773601001", 0, KPPInfo{Number: "773601001", TaxOffice: "7736", Region: "77", Reason: "01", Sequence: "001"}},
+		{"7736 01 001", 0, KPPInfo{Number: "773601001", TaxOffice: "7736", Region: "77", Reason: "01", Sequence: "001"}},
+		{"7736AB001", 0, KPPInfo{Number: "7736AB001", TaxOffice: "7736", Region: "77", Reason: "AB", Sequence: "001"}},
+		{"7736ab001", 2890, KPPInfo{}},
+		{"773600001", 2891, KPPInfo{}},
+		{"77360100", 2890, KPPInfo{}},
+		{"7736010011", 2890, KPPInfo{}},
+		{"77360100A", 2890, KPPInfo{}},
+		{73601001, 2809, KPPInfo{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParseKPP(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+}
+
+func TestKPPOfINN(t *testing.T) {
+	tests := []struct {
+		tag  string
+		inn  string
+		kpp  string
+		code int
+		args []interface{}
+	}{
+		{"registration", "7707083893", "773601001", 0, nil},
+		{"no INN", "", "773601001", 0, nil},
+		{"no KPP", "7707083893", "", 0, nil},
+		{"registration in another region", "7707083893", "540601001", 2892, []interface{}{"77"}},
+		{"branch in another region", "7707083893", "540602001", 2892, []interface{}{"77"}},
+		{"branch in the same region", "7707083893", "772802001", 0, nil},
+		{"largest taxpayer", "7736050003", "997250001", 0, nil},
+		{"foreign organization", "7707083893", "500551001", 0, nil},
+		{"letter reason", "7707083893", "5005AB001", 0, nil},
+		{"invalid KPP", "7707083893", "773600001", 2891, nil},
+		{"INN one digit off", "7707083894", "773601001", 2893, nil},
+		{"INN of an individual", "500100732259", "500101001", 2893, nil},
+	}
+
+	for _, test := range tests {
+		inn := test.inn
+		code, args := KPPOfINN(&inn).Validate(test.kpp)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("KPPOfINN %s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+
+		code, args = TaxRequisites{INN: test.inn, KPP: test.kpp}.Validate()
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("TaxRequisites %s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestTaxRequisites(t *testing.T) {
+	tests := []struct {
+		tag  string
+		req  TaxRequisites
+		code int
+	}{
+		{"empty", TaxRequisites{}, 0},
+		{"organization", TaxRequisites{INN: "7707083893", KPP: "773601001"}, 0},
+		{"organization without KPP", TaxRequisites{INN: "7707083893"}, 0},
+		{"individual", TaxRequisites{INN: "500100732259"}, 0},
+		{"individual one digit off", TaxRequisites{INN: "500100732258"}, 2803},
+		{"KPP without INN", TaxRequisites{KPP: "773601001"}, 0},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.req.Validate(); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
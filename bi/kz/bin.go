@@ -0,0 +1,109 @@
+package kz
+
+import (
+	"strconv"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/bi"
+)
+
+const (
+	// Resident is the type of a resident legal entity.
+	Resident EntityType = 4
+	// NonResident is the type of a non-resident legal entity.
+	NonResident EntityType = 5
+	// JointEntrepreneur is the type of an individual entrepreneur acting jointly.
+	JointEntrepreneur EntityType = 6
+)
+
+// EntityType is the type of the legal entity encoded in the BIN.
+type EntityType int
+
+var (
+	// BIN validates a 12-digit business identification number.
+	BIN = &binRule{code: 3014}
+	// IINOrBIN validates a number that is either an IIN or a BIN.
+	IINOrBIN = &iinOrBinRule{code: 3016}
+)
+
+// BINInfo is the information encoded in a business identification number.
+type BINInfo struct {
+	// Number is the BIN itself.
+	Number string
+	// Year is the year of the registration.
+	Year int
+	// Month is the month of the registration.
+	Month int
+	// Type is the type of the legal entity.
+	Type EntityType
+	// Division is 0 for a head organization, 1 for a branch, 2 for a representative office
+	// and 3 for a peasant farm.
+	Division int
+}
+
+// ParseBIN parses a BIN and returns a non-zero code if the value is not a valid BIN.
+func ParseBIN(value interface{}) (info BINInfo, code int) {
+	s, code := bi.Identifier(value, 3014, 12)
+	if code != 0 {
+		return
+	}
+	if !reDigits12.MatchString(s) {
+		code = 3011
+		return
+	}
+
+	month, _ := strconv.Atoi(s[2:4])
+	kind := EntityType(s[4] - '0')
+	division := int(s[5] - '0')
+	if month < 1 || month > 12 || kind < Resident || kind > JointEntrepreneur || division > 3 {
+		code = 3015
+		return
+	}
+
+	if !checkDigit(s) {
+		code = 3012
+		return
+	}
+
+	year, _ := strconv.Atoi(s[:2])
+	if year += 2000; year > time.Now().Year() {
+		year -= 100
+	}
+	info = BINInfo{Number: s, Year: year, Month: month, Type: kind, Division: division}
+	return
+}
+
+type binRule struct {
+	code int
+}
+
+func (r *binRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	_, code = ParseBIN(value)
+	return
+}
+
+type iinOrBinRule struct {
+	code int
+}
+
+func (r *iinOrBinRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	if _, c := ParseIIN(value); c == 0 {
+		return
+	}
+	if _, c := ParseBIN(value); c == 0 {
+		return
+	}
+	code = r.code
+	return
+}
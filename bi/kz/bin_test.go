@@ -0,0 +1,47 @@
+package kz
+
+import "testing"
+
+func TestParseBIN(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		info  BINInfo
+	}{
+		{"990140000384", 0, BINInfo{Number: "990140000384", Year: 1999, Month: 1, Type: Resident}},
+		{"050640001236", 0, BINInfo{Number: "050640001236", Year: 2005, Month: 6, Type: Resident}},
+		{"171141005550", 0, BINInfo{Number: "171141005550", Year: 2017, Month: 11, Type: Resident, Division: 1}},
+		{"990140000385", 3012, BINInfo{}},
+		{"990140001384", 3012, BINInfo{}},
+		{"991340000384", 3015, BINInfo{}},
+		{"990170000384", 3015, BINInfo{}},
+		{"990144000384", 3015, BINInfo{}},
+		{"99014000038", 3011, BINInfo{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParseBIN(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+}
+
+func TestIINOrBIN(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"880101300388", 0},
+		{"990140000384", 0},
+		{"880101300389", 3016},
+		{"990140000385", 3016},
+	}
+
+	for _, test := range tests {
+		if code, _ := IINOrBIN.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Package kz provides validation rules for the identification numbers of Kazakhstan.
+package kz
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/bi"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+const (
+	// Male is the gender of a man encoded in the IIN.
+	Male Gender = iota + 1
+	// Female is the gender of a woman encoded in the IIN.
+	Female
+)
+
+// Gender is the gender encoded in the IIN.
+type Gender int
+
+var (
+	reDigits12 = regexp.MustCompile(`^[0-9]{12}$`)
+
+	// checkWeights are the weights of the first pass of the control digit and of the second one,
+	// used when the first pass results in 10.
+	checkWeights = [2]checksum.Weighted{
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		{3, 4, 5, 6, 7, 8, 9, 10, 11, 1, 2},
+	}
+
+	// IIN validates a 12-digit individual identification number.
+	IIN = &iinRule{code: 3010}
+)
+
+// IINInfo is the information encoded in an individual identification number.
+type IINInfo struct {
+	// Number is the IIN itself.
+	Number string
+	// BirthDate is the date of birth of the person.
+	BirthDate time.Time
+	// Gender is the gender of the person.
+	Gender Gender
+}
+
+// ParseIIN parses an IIN and returns a non-zero code if the value is not a valid IIN.
+func ParseIIN(value interface{}) (info IINInfo, code int) {
+	s, code := bi.Identifier(value, 3010, 12)
+	if code != 0 {
+		return
+	}
+	if !reDigits12.MatchString(s) {
+		code = 3011
+		return
+	}
+
+	century := int(s[6] - '0')
+	if century < 1 || century > 6 {
+		code = 3013
+		return
+	}
+	year, _ := strconv.Atoi(s[:2])
+	birth, err := time.Parse("20060102", strconv.Itoa(1800+(century-1)/2*100+year)+s[2:6])
+	if err != nil {
+		code = 3013
+		return
+	}
+
+	if !checkDigit(s) {
+		code = 3012
+		return
+	}
+
+	info = IINInfo{Number: s, BirthDate: birth, Gender: Female}
+	if century%2 == 1 {
+		info.Gender = Male
+	}
+	return
+}
+
+type iinRule struct {
+	code int
+}
+
+func (r *iinRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	_, code = ParseIIN(value)
+	return
+}
+
+// checkDigit checks the control digit of an IIN or a BIN.
+func checkDigit(s string) bool {
+	for _, weights := range checkWeights {
+		if c := weights.Mod(s[:11], 11); c != 10 {
+			return c == int(s[11]-'0')
+		}
+	}
+	return false
+}
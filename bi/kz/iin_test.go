@@ -0,0 +1,36 @@
+package kz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseIIN(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		info  IINInfo
+	}{
+		{"880101300388", 0, IINInfo{Number: "880101300388", BirthDate: time.Date(1988, 1, 1, 0, 0, 0, 0, time.UTC), Gender: Male}},
+		{"920305450121", 0, IINInfo{Number: "920305450121", BirthDate: time.Date(1992, 3, 5, 0, 0, 0, 0, time.UTC), Gender: Female}},
+		{"8801 0130 0388", 0, IINInfo{Number: "880101300388", BirthDate: time.Date(1988, 1, 1, 0, 0, 0, 0, time.UTC), Gender: Male}},
+		{"880101300389", 3012, IINInfo{}},
+		{"880101300488", 3012, IINInfo{}},
+		{"880101700388", 3013, IINInfo{}},
+		{"881301300388", 3013, IINInfo{}},
+		{"88010130038", 3011, IINInfo{}},
+		{"88010130038A", 3011, IINInfo{}},
+		{80101300388, 2809, IINInfo{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParseIIN(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+
+	if code, _ := IIN.Validate(""); code != 0 {
+		t.Errorf("empty: got %v", code)
+	}
+}
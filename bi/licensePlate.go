@@ -0,0 +1,130 @@
+package bi
+
+import (
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+const (
+	// PlatePrivate is a private vehicle plate, e.g. "А123ВС77".
+	PlatePrivate PlateKind = iota + 1
+	// PlateTaxi is a taxi plate, e.g. "АВ12377".
+	PlateTaxi
+	// PlateTrailer is a trailer plate, e.g. "АВ1234777".
+	PlateTrailer
+	// PlateDiplomatic is a plate of a diplomatic mission, e.g. "123CD177".
+	PlateDiplomatic
+)
+
+// PlateKind is the kind of a vehicle registration plate.
+type PlateKind int
+
+// plateLetters are the Cyrillic letters that look like Latin ones, the only letters used on the plates.
+const plateLetters = "АВЕКМНОРСТУХ"
+
+// rePlateRegion matches a two-digit region code or a three-digit one, which starts with 1, 2, 7 or 9.
+var rePlateRegion = regexp.MustCompile(`^(0[1-9]|[1-9][0-9]|[1279][0-9]{2})$`)
+
+// plateFormats are the formats of the plates without the region code.
+var plateFormats = map[PlateKind]*regexp.Regexp{
+	PlatePrivate:    regexp.MustCompile(`^[` + plateLetters + `][0-9]{3}[` + plateLetters + `]{2}$`),
+	PlateTaxi:       regexp.MustCompile(`^[` + plateLetters + `]{2}[0-9]{3}$`),
+	PlateTrailer:    regexp.MustCompile(`^[` + plateLetters + `]{2}[0-9]{4}$`),
+	PlateDiplomatic: regexp.MustCompile(`^[0-9]{3}(CD|D|T)[0-9]{1,3}$`),
+}
+
+var (
+	// LicensePlate validates a Russian vehicle registration plate of any supported kind.
+	LicensePlate = &licensePlateRule{code: 2972}
+	// PrivatePlate validates a Russian private vehicle registration plate.
+	PrivatePlate = &licensePlateRule{kinds: []PlateKind{PlatePrivate}, code: 2972}
+	// TaxiPlate validates a Russian taxi registration plate.
+	TaxiPlate = &licensePlateRule{kinds: []PlateKind{PlateTaxi}, code: 2972}
+	// TrailerPlate validates a Russian trailer registration plate.
+	TrailerPlate = &licensePlateRule{kinds: []PlateKind{PlateTrailer}, code: 2972}
+	// DiplomaticPlate validates a Russian registration plate of a diplomatic mission.
+	DiplomaticPlate = &licensePlateRule{kinds: []PlateKind{PlateDiplomatic}, code: 2972}
+)
+
+// ParsePlate detects the kind of a vehicle registration plate and returns a non-zero code if it is not valid.
+// The letters may be in any case, but they must be Cyrillic. Since the number of digits is ambiguous
+// for some kinds, e.g. "АВ123177" is a taxi plate of the region 177 or a trailer plate of the region 77,
+// the first of the kinds returned by ParsePlateKinds is reported. Separate the region code with a space,
+// e.g. "АВ123 177", to make it unambiguous.
+func ParsePlate(value interface{}) (kind PlateKind, code int) {
+	kinds, code := ParsePlateKinds(value)
+	if code == 0 {
+		kind = kinds[0]
+	}
+	return
+}
+
+// ParsePlateKinds returns every kind a vehicle registration plate can be read as, trying both
+// a two-digit and a three-digit region code unless the region code is separated with a space.
+// A non-zero code is returned if the plate cannot be read as any kind.
+func ParsePlateKinds(value interface{}) (kinds []PlateKind, code int) {
+	raw, _, ok := identifierText(value)
+	if !ok {
+		code = 2972
+		return
+	}
+	raw = strings.ToUpper(raw)
+
+	splits := make([][2]string, 0, 2)
+	if fields := strings.Fields(raw); len(fields) > 1 && rePlateRegion.MatchString(fields[len(fields)-1]) {
+		splits = append(splits, [2]string{strings.Join(fields[:len(fields)-1], ""), fields[len(fields)-1]})
+	} else if s := strings.Join(fields, ""); len(s) > 3 {
+		splits = append(splits, [2]string{s[:len(s)-2], s[len(s)-2:]}, [2]string{s[:len(s)-3], s[len(s)-3:]})
+	}
+
+	for _, split := range splits {
+		if !rePlateRegion.MatchString(split[1]) {
+			continue
+		}
+		for _, k := range []PlateKind{PlatePrivate, PlateTaxi, PlateTrailer, PlateDiplomatic} {
+			if plateFormats[k].MatchString(split[0]) && !hasPlateKind(kinds, k) {
+				kinds = append(kinds, k)
+			}
+		}
+	}
+
+	if len(kinds) == 0 {
+		code = 2972
+	}
+	return
+}
+
+func hasPlateKind(kinds []PlateKind, kind PlateKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+type licensePlateRule struct {
+	kinds []PlateKind
+	code  int
+}
+
+func (r *licensePlateRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	kinds, code := ParsePlateKinds(value)
+	if code != 0 || len(r.kinds) == 0 {
+		return
+	}
+	for _, k := range r.kinds {
+		if hasPlateKind(kinds, k) {
+			return
+		}
+	}
+	code = r.code
+	return
+}
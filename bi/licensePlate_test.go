@@ -0,0 +1,70 @@
+package bi
+
+import (
+	"reflect"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestParsePlateKinds(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		kinds []PlateKind
+		code  int
+	}{
+		{"А123ВС77", []PlateKind{PlatePrivate}, 0},
+		{"а123вс777", []PlateKind{PlatePrivate}, 0},
+		{"А123ВС 777", []PlateKind{PlatePrivate}, 0},
+		{"АВ12377", []PlateKind{PlateTaxi}, 0},
+		{"АВ123177", []PlateKind{PlateTrailer, PlateTaxi}, 0},
+		{"АВ123 177", []PlateKind{PlateTaxi}, 0},
+		{"АВ1231 77", []PlateKind{PlateTrailer}, 0},
+		{"АВ1234777", []PlateKind{PlateTrailer}, 0},
+		{"123CD177", []PlateKind{PlateDiplomatic}, 0},
+		{"A123BC77", nil, 2972},
+		{"Б123ВС77", nil, 2972},
+		{"А123ВС00", nil, 2972},
+		{"А123ВС377", nil, 2972},
+		{"А12ВС77", nil, 2972},
+		{12377, nil, 2972},
+	}
+
+	for _, test := range tests {
+		kinds, code := ParsePlateKinds(test.value)
+		if code != test.code || !reflect.DeepEqual(kinds, test.kinds) {
+			t.Errorf("%v: got %v %v, want %v %v", test.value, kinds, code, test.kinds, test.code)
+		}
+	}
+
+	if kind, code := ParsePlate("АВ123177"); kind != PlateTrailer || code != 0 {
+		t.Errorf("ParsePlate: got %v %v", kind, code)
+	}
+}
+
+func TestLicensePlate(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"empty", TaxiPlate, "", 0},
+		{"any", LicensePlate, "А123ВС77", 0},
+		{"any invalid", LicensePlate, "А123ВС7", 2972},
+		{"private", PrivatePlate, "А123ВС77", 0},
+		{"private is not taxi", TaxiPlate, "А123ВС77", 2972},
+		{"taxi with a three-digit region", TaxiPlate, "АВ123177", 0},
+		{"trailer with a two-digit region", TrailerPlate, "АВ123177", 0},
+		{"not private", PrivatePlate, "АВ123177", 2972},
+		{"taxi with a separated region", TaxiPlate, "АВ1231 77", 2972},
+		{"diplomatic", DiplomaticPlate, "123D177", 0},
+		{"not diplomatic", DiplomaticPlate, "АВ12377", 2972},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
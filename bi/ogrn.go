@@ -0,0 +1,117 @@
+package bi
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/cadyrov/govalidation/is"
+)
+
+const (
+	// OGRNLegal is the kind of a 13-digit OGRN of a legal entity.
+	OGRNLegal OGRNKind = iota + 1
+	// OGRNIndividual is the kind of a 15-digit OGRNIP of an individual entrepreneur.
+	OGRNIndividual
+)
+
+// OGRNKind is the kind of the business an OGRN belongs to.
+type OGRNKind int
+
+// OGRN is the information encoded in a primary state registration number (OGRN or OGRNIP).
+type OGRN struct {
+	// Number is the OGRN itself.
+	Number string
+	// Kind tells whether the number belongs to a legal entity or to an individual entrepreneur.
+	Kind OGRNKind
+	// RecordType is the first digit of the number: 1 and 5 for OGRN, 3 for OGRNIP,
+	// other digits for state registration numbers of subsequent records.
+	RecordType int
+	// Year is the year the record was made.
+	Year int
+	// Region is the two-digit code of the region.
+	Region string
+	// TaxOffice is the two-digit code of the tax office within the region, empty for an OGRNIP.
+	TaxOffice string
+	// Record is the record number within the year.
+	Record string
+}
+
+// ParseOGRN parses a 13-digit OGRN of a legal entity or a 15-digit OGRNIP of an individual entrepreneur.
+// It returns a non-zero code if the value is not a valid OGRN.
+func ParseOGRN(value interface{}) (info OGRN, code int) {
+	s, code := identifier(value, 2860, 13, 15)
+	if code != 0 {
+		return
+	}
+
+	switch utf8.RuneCountInString(s) {
+	case 13:
+		return parseOgrnLaw(s)
+	case 15:
+		return parseOgrnIP(s)
+	}
+
+	code = 2860
+	return
+}
+
+func parseOgrnLaw(s string) (info OGRN, code int) {
+	if code, _ = is.Digit.Validate(s); code != 0 {
+		return
+	}
+
+	if utf8.RuneCountInString(s) != 13 {
+		code = 2841
+		return
+	}
+
+	if !checkOgrnDigit(s, 11) {
+		code = 2842
+		return
+	}
+
+	info = newOGRN(s, OGRNLegal)
+	info.TaxOffice, info.Record = s[5:7], s[7:12]
+	return
+}
+
+func parseOgrnIP(s string) (info OGRN, code int) {
+	if code, _ = is.Digit.Validate(s); code != 0 {
+		return
+	}
+
+	if utf8.RuneCountInString(s) != 15 {
+		code = 2851
+		return
+	}
+
+	if !checkOgrnDigit(s, 13) {
+		code = 2852
+		return
+	}
+
+	info = newOGRN(s, OGRNIndividual)
+	info.Record = s[5:14]
+	return
+}
+
+func newOGRN(s string, kind OGRNKind) OGRN {
+	year, _ := strconv.Atoi(s[1:3])
+
+	return OGRN{
+		Number:     s,
+		Kind:       kind,
+		RecordType: int(s[0] - '0'),
+		Year:       2000 + year,
+		Region:     s[3:5],
+	}
+}
+
+// checkOgrnDigit checks that the last digit of the number equals the last digit
+// of the remainder of the preceding digits divided by the module.
+func checkOgrnDigit(s string, module int64) bool {
+	body, _ := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	control := int64(s[len(s)-1] - '0')
+
+	return body%module%10 == control
+}
@@ -0,0 +1,62 @@
+package bi
+
+import (
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestParseOGRN(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		info  OGRN
+	}{
+		{"1027700132195", 0, OGRN{Number: "1027700132195", Kind: OGRNLegal, RecordType: 1, Year: 2002, Region: "77",
+			TaxOffice: "00", Record: "13219"}},
+		{"1037739010891", 0, OGRN{Number: "1037739010891", Kind: OGRNLegal, RecordType: 1, Year: 2003, Region: "77",
+			TaxOffice: "39", Record: "01089"}},
+		{"304500116000157", 0, OGRN{Number: "304500116000157", Kind: OGRNIndividual, RecordType: 3, Year: 2004,
+			Region: "50", Record: "011600015"}},
+		{int64(1027700132195), 0, OGRN{Number: "1027700132195", Kind: OGRNLegal, RecordType: 1, Year: 2002,
+			Region: "77", TaxOffice: "00", Record: "13219"}},
+		{"1027700132194", 2842, OGRN{}},
+		{"1027700132185", 2842, OGRN{}},
+		{"304500116000158", 2852, OGRN{}},
+		{"304500116000147", 2852, OGRN{}},
+		{"102770013219", 2860, OGRN{}},
+		{"30450011600015", 2860, OGRN{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParseOGRN(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+}
+
+func TestOgrnRules(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"OGRNLaw empty", OGRNLaw, "", 0},
+		{"OGRNLaw", OGRNLaw, "1027700132195", 0},
+		{"OGRNLaw one digit off", OGRNLaw, "1027700132196", 2842},
+		{"OGRNLaw 15 digits", OGRNLaw, "304500116000157", 2841},
+		{"OPGNIp", OPGNIp, "304500116000157", 0},
+		{"OPGNIp one digit off", OPGNIp, "304500116000156", 2852},
+		{"ORGNLawIp legal", ORGNLawIp, "1027700132195", 0},
+		{"ORGNLawIp individual", ORGNLawIp, "304500116000157", 0},
+		{"ORGNLawIp one digit off", ORGNLawIp, "1027700132197", 2860},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
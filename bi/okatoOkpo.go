@@ -1,9 +1,8 @@
 package bi
 
 import (
-	"strconv"
-
 	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/checksum"
 	"github.com/cadyrov/govalidation/is"
 )
 
@@ -19,16 +18,8 @@ func (inn *okatoOkpoRule) Validate(value interface{}) (code int, args []interfac
 		return
 	}
 
-	var s string
-	switch value.(type) {
-	case string:
-		s = value.(string)
-	case int:
-		s = strconv.Itoa(value.(int))
-	case int64:
-		s = strconv.FormatInt(value.(int64), 10)
-	default:
-		code = 2870
+	s, code := identifier(value, 2870, 8, 10, 11)
+	if code != 0 {
 		return
 	}
 
@@ -36,21 +27,8 @@ func (inn *okatoOkpoRule) Validate(value interface{}) (code int, args []interfac
 		return
 	}
 
-	digits := make([]int64, 0)
-	controlDigit := int64(0)
-	for _, val := range s {
-		x, _ := strconv.ParseInt(string(val), 10, 64)
-		controlDigit = x
-		digits = append(digits, x)
-	}
-
-	cn := controlStat(digits, 1, 10)
-	if cn == 10 {
-		cn = controlStat(digits, 3, 10)
-	}
-	if cn == 10 {
-		cn = 0
-	}
+	cn := okpoControl(s[:len(s)-1])
+	controlDigit := int64(s[len(s)-1] - '0')
 
 	if cn != controlDigit {
 		code = 2870
@@ -65,15 +43,21 @@ func (r *okatoOkpoRule) Error(message string) *snilsRule {
 	}
 }
 
-func controlStat(digits []int64, a int64, z int64) int64 {
-	current := a
-	sum := int64(0)
-	for i := 0; i < len(digits); i++ {
-		if current == 11 {
-			current = a
-		}
-		sum += digits[i] * current
-		current++
+var (
+	// okpoWeights are the weights of the first pass of the control digit of an OKPO or OKATO code,
+	// okpoWeights2 are the ones of the second pass used when the first one results in 10.
+	okpoWeights  = checksum.Weighted{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	okpoWeights2 = checksum.Weighted{3, 4, 5, 6, 7, 8, 9, 10, 1, 2}
+)
+
+// okpoControl calculates the control digit for the digits of an OKPO or OKATO code preceding it.
+func okpoControl(digits string) int64 {
+	cn := okpoWeights.Mod(digits, 11)
+	if cn == 10 {
+		cn = okpoWeights2.Mod(digits, 11)
+	}
+	if cn == 10 {
+		cn = 0
 	}
-	return sum % 11
+	return int64(cn)
 }
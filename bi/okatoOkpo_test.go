@@ -0,0 +1,38 @@
+package bi
+
+import (
+	"testing"
+)
+
+func TestOkatoOkpo(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		// the OKPO of Sberbank, rejected before the control digit calculation was fixed
+		{"00032537", 0},
+		{"00032538", 2870},
+		{"00032547", 2870},
+		{"57972160", 0},
+		{"57972161", 2870},
+		{"0123456789", 0},
+		{"0123456788", 2870},
+		{"01234-56789", 0},
+		{57972160, 0},
+		{"45286555000", 0},
+		{"45286555001", 2870},
+		{45286555000, 0},
+		// integers that have lost the leading zeros of an 8, 10 or 11-digit code
+		{32537, 2809},
+		{123456789, 2809},
+		{"5797216A", 1502},
+		{3.14, 2870},
+	}
+
+	for _, test := range tests {
+		if code, _ := OkatoOkpo.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package bi
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/is"
+)
+
+// OMSPolicy validates the 16-digit single number (ENP) of a compulsory medical insurance policy.
+var OMSPolicy = &omsPolicyRule{code: 2953}
+
+type omsPolicyRule struct {
+	code int
+}
+
+func (r *omsPolicyRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code, 16)
+	if code != 0 {
+		return
+	}
+	if code, args = is.Digit.Validate(s); code != 0 {
+		return
+	}
+	if utf8.RuneCountInString(s) != 16 {
+		code = r.code
+		return
+	}
+
+	if omsControl(s[:15]) != int(s[15]-'0') {
+		code = 2954
+	}
+	return
+}
+
+// omsControl calculates the control digit of an ENP. The digits in odd positions counting from the right
+// form a number that is doubled, the digits in even positions are prepended to it, and the control digit
+// complements the sum of all the resulting digits to a multiple of ten.
+func omsControl(s string) int {
+	var odd, even strings.Builder
+	for i := len(s) - 1; i >= 0; i-- {
+		if (len(s)-1-i)%2 == 0 {
+			odd.WriteByte(s[i])
+		} else {
+			even.WriteByte(s[i])
+		}
+	}
+
+	doubled, _ := strconv.ParseInt(odd.String(), 10, 64)
+	sum := 0
+	for _, c := range even.String() + strconv.FormatInt(doubled*2, 10) {
+		sum += int(c - '0')
+	}
+	return (10 - sum%10) % 10
+}
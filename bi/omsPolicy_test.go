@@ -0,0 +1,26 @@
+package bi
+
+import "testing"
+
+func TestOMSPolicy(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"7788990000123450", 0},
+		{"5000000000000017", 0},
+		{"5000 0000 0000 0017", 0},
+		{"7788990000123451", 2954},
+		{"7788990000123460", 2954},
+		{"778899000012345", 2953},
+		{"77889900001234500", 2953},
+		{"778899000012345A", 1502},
+	}
+
+	for _, test := range tests {
+		if code, _ := OMSPolicy.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
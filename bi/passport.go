@@ -0,0 +1,89 @@
+package bi
+
+import (
+	"regexp"
+	"time"
+)
+
+const (
+	// passportAge is the age a passport is issued at.
+	passportAge = 14
+	// passportGrace is the time given to replace a passport after it has expired.
+	passportGrace = 90 * 24 * time.Hour
+)
+
+// passportReplacementAges are the ages a passport has to be replaced at.
+var passportReplacementAges = []int{20, 45}
+
+var (
+	rePassport       = regexp.MustCompile(`^(0[1-9]|[1-9][0-9]) ?[0-9]{2} ?[0-9]{6}$`)
+	rePassportSeries = regexp.MustCompile(`^(0[1-9]|[1-9][0-9]) ?[0-9]{2}$`)
+	rePassportNumber = regexp.MustCompile(`^[0-9]{6}$`)
+	reDivisionCode   = regexp.MustCompile(`^(0[1-9]|[1-9][0-9])[0-9]-?[0-9]{3}$`)
+
+	// Passport validates the series and the number of an internal passport of a citizen of Russia:
+	// ten digits, optionally separated as "NN NN NNNNNN". The series starts with the OKATO region code,
+	// so it cannot start with "00".
+	Passport = &formatRule{re: rePassport, lengths: []int{10}, code: 2950}
+	// PassportSeries validates the four-digit series of a passport, optionally separated as "NN NN".
+	PassportSeries = &formatRule{re: rePassportSeries, lengths: []int{4}, code: 2957}
+	// PassportNumber validates the six-digit number of a passport.
+	PassportNumber = &formatRule{re: rePassportNumber, lengths: []int{6}, code: 2958}
+	// DivisionCode validates the "NNN-NNN" code of the division that issued a passport, the hyphen is optional.
+	DivisionCode = &formatRule{re: reDivisionCode, lengths: []int{6}, code: 2951}
+)
+
+// PassportData is a struct-level validator for an internal passport. Besides the format of the series,
+// the number and the division code it checks that the passport was issued at the age of 14 or later and
+// has not expired: a passport must be replaced at the age of 20 and 45, within 90 days.
+type PassportData struct {
+	Series       string    `json:"series"`
+	Number       string    `json:"number"`
+	DivisionCode string    `json:"divisionCode"`
+	BirthDate    time.Time `json:"birthDate"`
+	IssueDate    time.Time `json:"issueDate"`
+	// Now returns the current time, time.Now is used if it is nil.
+	Now func() time.Time `json:"-"`
+}
+
+// Validate checks the passport data. Empty fields are not checked.
+func (p PassportData) Validate() (code int, args []interface{}) {
+	if code, args = PassportSeries.Validate(p.Series); code != 0 {
+		return
+	}
+	if code, args = PassportNumber.Validate(p.Number); code != 0 {
+		return
+	}
+	if code, args = DivisionCode.Validate(p.DivisionCode); code != 0 {
+		return
+	}
+	if p.BirthDate.IsZero() || p.IssueDate.IsZero() {
+		return
+	}
+
+	now := time.Now()
+	if p.Now != nil {
+		now = p.Now()
+	}
+
+	switch {
+	case p.IssueDate.Before(p.BirthDate):
+		code = 2960
+	case p.IssueDate.After(now):
+		code = 2962
+	case p.IssueDate.Before(p.BirthDate.AddDate(passportAge, 0, 0)):
+		code = 2961
+	}
+	if code != 0 {
+		return
+	}
+
+	for _, age := range passportReplacementAges {
+		birthday := p.BirthDate.AddDate(age, 0, 0)
+		if p.IssueDate.Before(birthday) && now.After(birthday.Add(passportGrace)) {
+			code, args = 2963, []interface{}{age}
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,80 @@
+package bi
+
+import (
+	"testing"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestPassport(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"empty", Passport, "", 0},
+		{"passport", Passport, "4508123456", 0},
+		{"separated", Passport, "45 08 123456", 0},
+		{"region 00", Passport, "0008123456", 2950},
+		{"short", Passport, "450812345", 2950},
+		{"letter", Passport, "45081234S6", 2950},
+		{"lost zero", Passport, 108123456, 2809},
+		{"series", PassportSeries, "45 08", 0},
+		{"series 00", PassportSeries, "0008", 2957},
+		{"series too long", PassportSeries, "45081", 2957},
+		{"number", PassportNumber, "012345", 0},
+		{"number too short", PassportNumber, "12345", 2958},
+		{"division code", DivisionCode, "770-001", 0},
+		{"division code 00", DivisionCode, "000-001", 2951},
+		{"division code without a hyphen", DivisionCode, "770001", 0},
+		{"division code as an integer", DivisionCode, 770001, 0},
+		{"division code lost zero", DivisionCode, 70001, 2809},
+		{"division code hyphen misplaced", DivisionCode, "7700-01", 0},
+		{"division code with other separators", WithSeparators("", DivisionCode), "770 001", 2951},
+		{"division code too long", DivisionCode, "770-0011", 2951},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestPassportData(t *testing.T) {
+	date := func(y, m, d int) time.Time { return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC) }
+	now := func() time.Time { return date(2024, 6, 1) }
+	birth := date(2000, 3, 15)
+
+	tests := []struct {
+		tag  string
+		data PassportData
+		code int
+	}{
+		{"empty", PassportData{}, 0},
+		{"valid", PassportData{Series: "45 08", Number: "123456", DivisionCode: "770-001",
+			BirthDate: birth, IssueDate: date(2020, 4, 1), Now: now}, 0},
+		{"series only", PassportData{Series: "4508"}, 0},
+		{"number only", PassportData{Number: "123456"}, 0},
+		// a digit moved from the number to the series used to pass as the concatenation is the same
+		{"digit moved to the series", PassportData{Series: "45081", Number: "23456"}, 2957},
+		{"short number", PassportData{Series: "4508", Number: "12345"}, 2958},
+		{"division code", PassportData{Series: "4508", Number: "123456", DivisionCode: "770-01"}, 2951},
+		{"issued before birth", PassportData{BirthDate: birth, IssueDate: date(1999, 1, 1), Now: now}, 2960},
+		{"issued at 13", PassportData{BirthDate: birth, IssueDate: date(2014, 3, 14), Now: now}, 2961},
+		{"issued at 14", PassportData{BirthDate: birth, IssueDate: date(2014, 3, 15),
+			Now: func() time.Time { return date(2015, 1, 1) }}, 0},
+		{"issued in the future", PassportData{BirthDate: birth, IssueDate: date(2024, 6, 2), Now: now}, 2962},
+		{"not replaced at 20", PassportData{BirthDate: birth, IssueDate: date(2014, 4, 1), Now: now}, 2963},
+		{"within the grace period", PassportData{BirthDate: birth, IssueDate: date(2014, 4, 1),
+			Now: func() time.Time { return date(2020, 6, 1) }}, 0},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.data.Validate(); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package bi
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+const (
+	// PhoneMobile is a number with a DEF code of a mobile network.
+	PhoneMobile PhoneKind = iota + 1
+	// PhoneLandline is a number with an ABC code of a geographic area.
+	PhoneLandline
+	// PhoneService is a toll-free or a premium-rate number with a code from 800 to 809.
+	PhoneService
+)
+
+// defaultPhoneCodes are the ranges of the DEF and ABC codes of the Russian numbering plan
+// in the format read by ReadPhoneCodes.
+const defaultPhoneCodes = `
+900;906;mobile
+908;939;mobile
+941;941;mobile
+949;971;mobile
+977;978;mobile
+980;989;mobile
+991;999;mobile
+301;302;landline
+336;336;landline
+341;343;landline
+345;347;landline
+349;349;landline
+351;353;landline
+365;365;landline
+381;385;landline
+388;388;landline
+390;391;landline
+394;395;landline
+401;401;landline
+411;411;landline
+413;413;landline
+415;416;landline
+421;421;landline
+423;424;landline
+426;427;landline
+471;475;landline
+481;487;landline
+491;496;landline
+498;499;landline
+800;809;service
+811;811;landline
+813;818;landline
+820;821;landline
+831;831;landline
+833;836;landline
+841;848;landline
+851;851;landline
+855;855;landline
+861;863;landline
+865;867;landline
+869;869;landline
+871;873;landline
+877;879;landline
+`
+
+// phoneKinds are the names of the phone kinds in the code ranges.
+var phoneKinds = map[string]PhoneKind{"mobile": PhoneMobile, "landline": PhoneLandline, "service": PhoneService}
+
+// rePhoneCode matches a three-digit ABC or DEF code.
+var rePhoneCode = regexp.MustCompile(`^[0-9]{3}$`)
+
+// PhoneKind is the kind of a Russian phone number.
+type PhoneKind int
+
+// phoneFormatting are the characters allowed to format a phone number besides the digits and the leading plus.
+const phoneFormatting = " \u00a0\t-()."
+
+var (
+	// Phone validates a Russian phone number such as "8 (912) 345-67-89" or "+7 912 3456789"
+	// with an ABC or DEF code of the numbering plan. Call Codes to use other code ranges.
+	Phone = &phoneRule{codes: phoneCodes, code: 2990}
+	// MobilePhone validates a Russian mobile phone number.
+	MobilePhone = &phoneRule{codes: phoneCodes, kinds: []PhoneKind{PhoneMobile}, code: 2992}
+	// PhoneE164 is a filter that converts a valid Russian phone number into the "+7XXXXXXXXXX" form.
+	// Invalid values are left intact for the rules following it to report.
+	PhoneE164 = validation.NewStringFilter(phoneE164)
+
+	phoneCodes, _ = ReadPhoneCodes(strings.NewReader(defaultPhoneCodes))
+)
+
+// PhoneNumber is the information about a Russian phone number.
+type PhoneNumber struct {
+	// E164 is the number in the "+7XXXXXXXXXX" form.
+	E164 string
+	// Code is the three-digit ABC or DEF code.
+	Code string
+	// Subscriber is the seven-digit number of the subscriber.
+	Subscriber string
+	// Kind tells whether the number is a mobile, a landline or a service one.
+	Kind PhoneKind
+}
+
+// ParsePhone parses a Russian phone number in the national or international form and returns
+// a non-zero code if it is not a valid number. The kind is told by the range of the embedded
+// numbering plan the code belongs to, and a code out of the plan, such as a Kazakhstan one or
+// a code not allocated yet, is rejected. For a well-formed number with such a code,
+// the code is still set in the returned info.
+func ParsePhone(value interface{}) (info PhoneNumber, code int) {
+	return parsePhone(value, phoneCodes)
+}
+
+func parsePhone(value interface{}, codes *PhoneCodes) (info PhoneNumber, code int) {
+	s, _, ok := identifierText(value)
+	if !ok {
+		code = 2990
+		return
+	}
+
+	s = strings.TrimSpace(s)
+	plus := strings.HasPrefix(s, "+")
+	digits := strings.Map(func(c rune) rune {
+		if strings.ContainsRune(phoneFormatting, c) {
+			return -1
+		}
+		return c
+	}, strings.TrimPrefix(s, "+"))
+	if strings.Trim(digits, "0123456789") != "" {
+		code = 2990
+		return
+	}
+
+	switch {
+	case len(digits) == 11 && (digits[0] == '7' || digits[0] == '8' && !plus):
+		digits = digits[1:]
+	case len(digits) != 10 || plus:
+		code = 2990
+		return
+	}
+
+	info = PhoneNumber{E164: "+7" + digits, Code: digits[:3], Subscriber: digits[3:]}
+	if info.Kind = codes.Kind(info.Code); info.Kind == 0 {
+		// the code is kept in the info for the error message
+		code = 2991
+	}
+	return
+}
+
+type phoneRule struct {
+	codes *PhoneCodes
+	kinds []PhoneKind
+	code  int
+}
+
+// Codes returns a copy of the rule that checks the code of the number against the ranges
+// instead of the embedded numbering plan.
+func (r *phoneRule) Codes(codes *PhoneCodes) *phoneRule {
+	return &phoneRule{codes: codes, kinds: r.kinds, code: r.code}
+}
+
+func (r *phoneRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	info, code := parsePhone(value, r.codes)
+	if code == 2991 {
+		args = []interface{}{info.Code}
+	}
+	if code != 0 || len(r.kinds) == 0 {
+		return
+	}
+	for _, k := range r.kinds {
+		if k == info.Kind {
+			return
+		}
+	}
+	code = r.code
+	return
+}
+
+func phoneE164(s string) string {
+	info, code := ParsePhone(s)
+	if code != 0 {
+		return s
+	}
+	return info.E164
+}
+
+// PhoneCodes are the ranges of the ABC and DEF codes of a numbering plan.
+type PhoneCodes struct {
+	ranges []phoneCodeRange
+}
+
+type phoneCodeRange struct {
+	from, to string
+	kind     PhoneKind
+}
+
+// LoadPhoneCodes loads the code ranges from a local CSV file, see ReadPhoneCodes.
+func LoadPhoneCodes(path string) (*PhoneCodes, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadPhoneCodes(f)
+}
+
+// ReadPhoneCodes reads the code ranges from semicolon separated CSV data. Every line holds the first
+// and the last three-digit code of a range and its kind, "mobile", "landline" or "service",
+// e.g. "910;919;mobile". Lines with a non-numeric code or an unknown kind, such as a header, are skipped.
+func ReadPhoneCodes(r io.Reader) (*PhoneCodes, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = ';'
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &PhoneCodes{}
+	for _, rec := range records {
+		if len(rec) < 3 {
+			continue
+		}
+		from, to := strings.TrimSpace(rec[0]), strings.TrimSpace(rec[1])
+		kind, ok := phoneKinds[strings.ToLower(strings.TrimSpace(rec[2]))]
+		if !ok || !rePhoneCode.MatchString(from) || !rePhoneCode.MatchString(to) {
+			continue
+		}
+		c.ranges = append(c.ranges, phoneCodeRange{from: from, to: to, kind: kind})
+	}
+	return c, nil
+}
+
+// Kind returns the kind of the numbers with the code, or zero if the code is out of the ranges.
+func (c *PhoneCodes) Kind(code string) PhoneKind {
+	for _, r := range c.ranges {
+		if code >= r.from && code <= r.to {
+			return r.kind
+		}
+	}
+	return 0
+}
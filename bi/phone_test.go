@@ -0,0 +1,109 @@
+package bi
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestParsePhone(t *testing.T) {
+	mobile := PhoneNumber{E164: "+79123456789", Code: "912", Subscriber: "3456789", Kind: PhoneMobile}
+
+	tests := []struct {
+		value interface{}
+		code  int
+		info  PhoneNumber
+	}{
+		{"8 (912) 345-67-89", 0, mobile},
+		{"+7 912 3456789", 0, mobile},
+		{"79123456789", 0, mobile},
+		{"9123456789", 0, mobile},
+		{79123456789, 0, mobile},
+		{"+7 (495) 123-45-67", 0, PhoneNumber{E164: "+74951234567", Code: "495", Subscriber: "1234567", Kind: PhoneLandline}},
+		{"8 (343) 123.45.67", 0, PhoneNumber{E164: "+73431234567", Code: "343", Subscriber: "1234567", Kind: PhoneLandline}},
+		{"8 861 123 45 67", 0, PhoneNumber{E164: "+78611234567", Code: "861", Subscriber: "1234567", Kind: PhoneLandline}},
+		{"8 800 555-35-35", 0, PhoneNumber{E164: "+78005553535", Code: "800", Subscriber: "5553535", Kind: PhoneService}},
+		{"+7 701 234 56 78", 2991, PhoneNumber{E164: "+77012345678", Code: "701", Subscriber: "2345678"}},
+		{"+7 899 123 45 67", 2991, PhoneNumber{E164: "+78991234567", Code: "899", Subscriber: "1234567"}},
+		{"+7 907 123 45 67", 2991, PhoneNumber{E164: "+79071234567", Code: "907", Subscriber: "1234567"}},
+		{"+7 975 123 45 67", 2991, PhoneNumber{E164: "+79751234567", Code: "975", Subscriber: "1234567"}},
+		{"+7 990 123 45 67", 2991, PhoneNumber{E164: "+79901234567", Code: "990", Subscriber: "1234567"}},
+		{"+7 499 123 45 67", 0, PhoneNumber{E164: "+74991234567", Code: "499", Subscriber: "1234567", Kind: PhoneLandline}},
+		{"+7 497 123 45 67", 2991, PhoneNumber{E164: "+74971234567", Code: "497", Subscriber: "1234567"}},
+		{"+7 978 123 45 67", 0, PhoneNumber{E164: "+79781234567", Code: "978", Subscriber: "1234567", Kind: PhoneMobile}},
+		{"+7 989 123 45 67", 0, PhoneNumber{E164: "+79891234567", Code: "989", Subscriber: "1234567", Kind: PhoneMobile}},
+		{"+7 365 123 45 67", 0, PhoneNumber{E164: "+73651234567", Code: "365", Subscriber: "1234567", Kind: PhoneLandline}},
+		{"+8 912 345 67 89", 2990, PhoneNumber{}},
+		{"+9123456789", 2990, PhoneNumber{}},
+		{"8 912 345-67", 2990, PhoneNumber{}},
+		{"8 912 345-67-890", 2990, PhoneNumber{}},
+		{"8 912 345-67-8A", 2990, PhoneNumber{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParsePhone(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+}
+
+func TestPhone(t *testing.T) {
+	codes, err := ReadPhoneCodes(strings.NewReader("from;to;kind\n907;907;mobile\n495;495;landline\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", Phone, "", 0, nil},
+		{"mobile", Phone, "8 (912) 345-67-89", 0, nil},
+		{"landline", Phone, "+7 495 123-45-67", 0, nil},
+		{"code not used in Russia", Phone, "+7 701 234 56 78", 2991, []interface{}{"701"}},
+		{"invalid", Phone, "12345", 2990, nil},
+		{"mobile only", MobilePhone, "+7 912 345 67 89", 0, nil},
+		{"landline is not mobile", MobilePhone, "+7 495 123 45 67", 2992, nil},
+		{"service is not mobile", MobilePhone, "8 800 555 35 35", 2992, nil},
+		{"unallocated code", Phone, "+7 907 123 45 67", 2991, []interface{}{"907"}},
+		{"loaded code", Phone.Codes(codes), "+7 907 123 45 67", 0, nil},
+		{"code missing from the loaded ones", Phone.Codes(codes), "+7 912 345 67 89", 2991, []interface{}{"912"}},
+		{"loaded mobile code", MobilePhone.Codes(codes), "+7 907 123 45 67", 0, nil},
+		{"loaded landline code", MobilePhone.Codes(codes), "+7 495 123 45 67", 2992, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestPhoneE164(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"8 (912) 345-67-89", "+79123456789"},
+		{"+7 495 123-45-67", "+74951234567"},
+		{"not a phone", "not a phone"},
+	}
+
+	for _, test := range tests {
+		if got := PhoneE164.Filter(test.value); got != test.want {
+			t.Errorf("%v: got %v, want %v", test.value, got, test.want)
+		}
+	}
+
+	phone := " 8 (912) 345-67-89"
+	if err := validation.Validate(&phone, PhoneE164, MobilePhone); err != nil || phone != "+79123456789" {
+		t.Errorf("write back: got %q %v", phone, err)
+	}
+}
@@ -8,31 +8,36 @@ import (
 	"github.com/cadyrov/govalidation/is"
 )
 
+// snilsMinChecked is the lowest SNILS number protected by the control sum.
+const snilsMinChecked = 1001998
+
 var Snils = &snilsRule{code: 2808}
 
-type snilsRule struct {
-	code int
+// SNILS is the information encoded in an insurance number of an individual ledger account.
+type SNILS struct {
+	// Number is the SNILS itself without separators.
+	Number string
+	// Control is the two-digit control sum.
+	Control int
 }
 
-func (inn *snilsRule) Validate(value interface{}) (code int, args []interface{}) {
-	value, isNil := validation.Indirect(value)
-	if isNil || validation.IsEmpty(value) {
-		return
+// Formatted returns the SNILS in the conventional "XXX-XXX-XXX YY" form.
+func (s SNILS) Formatted() string {
+	if len(s.Number) != 11 {
+		return s.Number
 	}
-	var s string
-	switch value.(type) {
-	case string:
-		s = value.(string)
-	case int:
-		s = strconv.Itoa(value.(int))
-	case int64:
-		s = strconv.FormatInt(value.(int64), 10)
-	default:
-		code = 2880
+	return s.Number[:3] + "-" + s.Number[3:6] + "-" + s.Number[6:9] + " " + s.Number[9:]
+}
+
+// ParseSNILS parses an 11-digit SNILS.
+// It returns a non-zero code if the value is not a valid SNILS.
+func ParseSNILS(value interface{}) (info SNILS, code int) {
+	s, code := identifier(value, 2880, 11)
+	if code != 0 {
 		return
 	}
 
-	if code, args = is.Digit.Validate(s); code != 0 {
+	if code, _ = is.Digit.Validate(s); code != 0 {
 		return
 	}
 
@@ -41,21 +46,41 @@ func (inn *snilsRule) Validate(value interface{}) (code int, args []interface{})
 		return
 	}
 
-	sumSnils := int64(0)
-	for i := 1; i < 10; i++ {
-		x, _ := strconv.ParseInt(string(s[9-i]), 10, 64)
-		sumSnils += int64(i) * x
-	}
-	cntrl := snilsControl(sumSnils)
-	must, _ := strconv.ParseInt(string(s[9:]), 10, 64)
-	if must != cntrl {
+	number, _ := strconv.ParseInt(s[:9], 10, 64)
+	must, _ := strconv.ParseInt(s[9:], 10, 64)
+	if number > snilsMinChecked && must != snilsSum(s) {
 		code = 2880
 		return
 	}
 
+	info = SNILS{Number: s, Control: int(must)}
 	return
 }
 
+type snilsRule struct {
+	code int
+}
+
+func (inn *snilsRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	_, code = ParseSNILS(value)
+	return
+}
+
+// snilsSum calculates the control sum of the first nine digits of a SNILS.
+func snilsSum(s string) int64 {
+	sumSnils := int64(0)
+	for i := 1; i < 10; i++ {
+		x, _ := strconv.ParseInt(string(s[9-i]), 10, 64)
+		sumSnils += int64(i) * x
+	}
+	return snilsControl(sumSnils)
+}
+
 func snilsControl(input int64) int64 {
 	if input < 100 {
 		return input
@@ -0,0 +1,49 @@
+package bi
+
+import (
+	"testing"
+)
+
+func TestParseSNILS(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		code  int
+		info  SNILS
+	}{
+		{"11223344595", 0, SNILS{Number: "11223344595", Control: 95}},
+		{"112-233-445 95", 0, SNILS{Number: "11223344595", Control: 95}},
+		{"087-654-303 00", 0, SNILS{Number: "08765430300", Control: 0}},
+		{int64(11223344595), 0, SNILS{Number: "11223344595", Control: 95}},
+		{"11223344596", 2880, SNILS{}},
+		{"11223344585", 2880, SNILS{}},
+		{"1122334459", 2880, SNILS{}},
+		{"112233445950", 2880, SNILS{}},
+		{8765430300, 2809, SNILS{}},
+		// the numbers up to 001-001-998 are issued without the control sum
+		{"001-001-998 00", 0, SNILS{Number: "00100199800", Control: 0}},
+		{"001-001-998 47", 0, SNILS{Number: "00100199847", Control: 47}},
+		{"001-001-999 12", 2880, SNILS{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParseSNILS(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+}
+
+func TestSnils(t *testing.T) {
+	if code, _ := Snils.Validate(""); code != 0 {
+		t.Errorf("empty: got %v", code)
+	}
+	if code, _ := Snils.Validate("112 233 445 95"); code != 0 {
+		t.Errorf("valid: got %v", code)
+	}
+	if code, _ := Snils.Validate("112 233 445 94"); code != 2880 {
+		t.Errorf("one digit off: got %v", code)
+	}
+	if s := (SNILS{Number: "11223344595"}).Formatted(); s != "112-233-445 95" {
+		t.Errorf("Formatted: got %q", s)
+	}
+}
@@ -0,0 +1,78 @@
+// Package uz provides validation rules for the identification numbers of Uzbekistan.
+package uz
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/bi"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+var (
+	// rePinfl matches a 14-digit PINFL (JShShIR).
+	rePinfl = regexp.MustCompile(`^[1-6][0-9]{13}$`)
+
+	// pinflWeights are the weights of the control digit of a PINFL.
+	pinflWeights = checksum.Weighted{7, 3, 1}
+
+	// PINFL validates a 14-digit personal identification number of an individual.
+	PINFL = &pinflRule{code: 3031}
+)
+
+// PINFLInfo is the information encoded in a personal identification number.
+type PINFLInfo struct {
+	// Number is the PINFL itself.
+	Number string
+	// BirthDate is the date of birth of the person.
+	BirthDate time.Time
+	// Male tells whether the person is a man.
+	Male bool
+	// Region is the three-digit code of the region that issued the number.
+	Region string
+}
+
+// ParsePINFL parses a PINFL and returns a non-zero code if the value is not a valid PINFL.
+// The first digit encodes the century and the gender, the next six the date of birth as DDMMYY.
+func ParsePINFL(value interface{}) (info PINFLInfo, code int) {
+	s, code := bi.Identifier(value, 3031, 14)
+	if code != 0 {
+		return
+	}
+	if !rePinfl.MatchString(s) {
+		code = 3031
+		return
+	}
+
+	century := int(s[0] - '0')
+	year, _ := strconv.Atoi(s[5:7])
+	birth, err := time.Parse("20060102", strconv.Itoa(1800+(century-1)/2*100+year)+s[3:5]+s[1:3])
+	if err != nil {
+		code = 3032
+		return
+	}
+
+	if pinflWeights.Mod(s[:13], 10) != int(s[13]-'0') {
+		code = 3033
+		return
+	}
+
+	info = PINFLInfo{Number: s, BirthDate: birth, Male: century%2 == 1, Region: s[7:10]}
+	return
+}
+
+type pinflRule struct {
+	code int
+}
+
+func (r *pinflRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	_, code = ParsePINFL(value)
+	return
+}
@@ -0,0 +1,36 @@
+package uz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParsePINFL(t *testing.T) {
+	date := func(y, m, d int) time.Time { return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		value interface{}
+		code  int
+		info  PINFLInfo
+	}{
+		{"30101990001233", 0, PINFLInfo{Number: "30101990001233", BirthDate: date(1999, 1, 1), Male: true, Region: "000"}},
+		{"41503925700529", 0, PINFLInfo{Number: "41503925700529", BirthDate: date(1992, 3, 15), Region: "570"}},
+		{"53105020250018", 0, PINFLInfo{Number: "53105020250018", BirthDate: date(2002, 5, 31), Male: true, Region: "025"}},
+		{"30101990001234", 3033, PINFLInfo{}},
+		{"30101990001133", 3033, PINFLInfo{}},
+		{"33202990001233", 3032, PINFLInfo{}},
+		{"70101990001233", 3031, PINFLInfo{}},
+		{"3010199000123", 3031, PINFLInfo{}},
+	}
+
+	for _, test := range tests {
+		info, code := ParsePINFL(test.value)
+		if code != test.code || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, code, info, test.code, test.info)
+		}
+	}
+
+	if code, _ := PINFL.Validate(""); code != 0 {
+		t.Errorf("empty: got %v", code)
+	}
+}
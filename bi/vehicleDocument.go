@@ -0,0 +1,50 @@
+package bi
+
+import (
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+var (
+	// reVehicleDocument matches the series of two digits and two digits or Cyrillic letters followed by a six-digit number.
+	reVehicleDocument = regexp.MustCompile(`^[0-9]{2}([0-9]{2}|[` + plateLetters + `]{2})[0-9]{6}$`)
+	// rePaperPts matches the series of two digits and two Cyrillic letters followed by a six-digit number.
+	rePaperPts = regexp.MustCompile(`^[0-9]{2}[` + plateLetters + `]{2}[0-9]{6}$`)
+	// reEpts matches the 15 digits of an electronic vehicle passport, starting with the kind of the vehicle.
+	reEpts = regexp.MustCompile(`^[1-3][0-9]{14}$`)
+
+	// STS validates the series and the number of a vehicle registration certificate, e.g. "77 УО 123456" or "99 12 345678".
+	STS = &vehicleDocumentRule{formats: []*regexp.Regexp{reVehicleDocument}, code: 2973}
+	// PTS validates the series and the number of a paper vehicle passport or the number of an electronic one.
+	PTS = &vehicleDocumentRule{formats: []*regexp.Regexp{rePaperPts, reEpts}, code: 2974}
+	// EPTS validates the 15-digit number of an electronic vehicle passport.
+	EPTS = &vehicleDocumentRule{formats: []*regexp.Regexp{reEpts}, code: 2975}
+)
+
+type vehicleDocumentRule struct {
+	formats []*regexp.Regexp
+	code    int
+}
+
+func (r *vehicleDocumentRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code)
+	if code != 0 {
+		return
+	}
+	s = strings.ToUpper(s)
+
+	for _, re := range r.formats {
+		if re.MatchString(s) {
+			return
+		}
+	}
+	code = r.code
+	return
+}
@@ -0,0 +1,36 @@
+package bi
+
+import (
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestVehicleDocuments(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"empty", STS, "", 0},
+		{"STS with letters", STS, "77 УО 123456", 0},
+		{"STS with digits", STS, "99 12 345678", 0},
+		{"STS lower case", STS, "77уо123456", 0},
+		{"STS Latin letters", STS, "77 YO 123456", 2973},
+		{"STS short number", STS, "77 УО 12345", 2973},
+		{"PTS", PTS, "78 ТХ 123456", 0},
+		{"PTS electronic", PTS, "164301001234567", 0},
+		{"PTS with digits", PTS, "78 12 123456", 2974},
+		{"EPTS", EPTS, "164301001234567", 0},
+		{"EPTS kind", EPTS, "464301001234567", 2975},
+		{"EPTS 14 digits", EPTS, "16430100123456", 2975},
+		{"EPTS paper", EPTS, "78 ТХ 123456", 2975},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
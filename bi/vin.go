@@ -0,0 +1,73 @@
+package bi
+
+import (
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// reVin matches 17 characters of a VIN: Latin letters except I, O and Q, and digits.
+var reVin = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+
+var (
+	// vinWeights are the ISO 3779 weights of the VIN positions, the check digit itself is in the ninth one.
+	vinWeights = []int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+	// vinValues are the values the VIN letters are transliterated to.
+	vinValues = map[rune]int{
+		'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+		'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+		'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+	}
+)
+
+// VIN validates a 17-character vehicle identification number.
+// The check digit is not mandatory in Russia, call CheckDigit to verify it as well.
+var VIN = &vinRule{code: 2970}
+
+type vinRule struct {
+	checkDigit bool
+	code       int
+}
+
+// CheckDigit returns a copy of the rule that also verifies the ISO 3779 check digit in the ninth position.
+func (r *vinRule) CheckDigit() *vinRule {
+	return &vinRule{checkDigit: true, code: r.code}
+}
+
+func (r *vinRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := identifier(value, r.code)
+	if code != 0 {
+		return
+	}
+	s = strings.ToUpper(s)
+	if !reVin.MatchString(s) {
+		code = r.code
+		return
+	}
+
+	if r.checkDigit && vinCheckDigit(s) != s[8] {
+		code = 2971
+	}
+	return
+}
+
+func vinCheckDigit(s string) byte {
+	sum := 0
+	for i, c := range s {
+		v, ok := vinValues[c]
+		if !ok {
+			v = int(c - '0')
+		}
+		sum += v * vinWeights[i]
+	}
+	if sum%11 == 10 {
+		return 'X'
+	}
+	return byte('0' + sum%11)
+}
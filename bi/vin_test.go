@@ -0,0 +1,36 @@
+package bi
+
+import (
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestVIN(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"empty", VIN, "", 0},
+		{"vin", VIN, "1M8GDM9AXKP042788", 0},
+		{"lower case", VIN, "1m8gdm9axkp042788", 0},
+		{"wrong check digit without the check", VIN, "1M8GDM9A1KP042788", 0},
+		{"letter O", VIN, "1M8GDM9AXKP04278O", 2970},
+		{"letter I", VIN, "1M8GDM9AXKPI42788", 2970},
+		{"16 characters", VIN, "1M8GDM9AXKP04278", 2970},
+		{"18 characters", VIN, "1M8GDM9AXKP0427880", 2970},
+		{"check digit X", VIN.CheckDigit(), "1M8GDM9AXKP042788", 0},
+		{"check digit", VIN.CheckDigit(), "5GZCZ43D13S812715", 0},
+		{"ones", VIN.CheckDigit(), "11111111111111111", 0},
+		{"check digit off", VIN.CheckDigit(), "1M8GDM9AXKP042789", 2971},
+		{"another check digit off", VIN.CheckDigit(), "5GZCZ43D23S812715", 2971},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Package card provides validation rules for payment cards: the number, the expiry date and the CVV.
+package card
+
+import (
+	"strings"
+)
+
+const (
+	// Visa is the brand of the Visa cards.
+	Visa Brand = "visa"
+	// Mastercard is the brand of the Mastercard cards.
+	Mastercard Brand = "mastercard"
+	// MIR is the brand of the Russian national payment system cards.
+	MIR Brand = "mir"
+	// UnionPay is the brand of the China UnionPay cards.
+	UnionPay Brand = "unionpay"
+	// Amex is the brand of the American Express cards.
+	Amex Brand = "amex"
+	// JCB is the brand of the JCB cards.
+	JCB Brand = "jcb"
+)
+
+// Brand is a payment network.
+type Brand string
+
+// brandInfo describes the cards of a brand.
+type brandInfo struct {
+	// ranges are the inclusive IIN ranges, both ends having the same number of digits.
+	ranges [][2]string
+	// lengths are the allowed lengths of the card number.
+	lengths []int
+	// cvv is the number of digits of the card security code.
+	cvv int
+	// luhn tells whether the last digit of the number is a Luhn control digit.
+	luhn bool
+}
+
+// brands are the known brands. A number is matched by the range with the longest prefix.
+var brands = map[Brand]brandInfo{
+	Visa:       {ranges: [][2]string{{"4", "4"}}, lengths: []int{13, 16, 19}, cvv: 3, luhn: true},
+	Mastercard: {ranges: [][2]string{{"51", "55"}, {"2221", "2720"}}, lengths: []int{16}, cvv: 3, luhn: true},
+	MIR:        {ranges: [][2]string{{"2200", "2204"}}, lengths: []int{16, 17, 18, 19}, cvv: 3, luhn: true},
+	// Some UnionPay cards do not have a Luhn control digit.
+	UnionPay: {ranges: [][2]string{{"62", "62"}, {"81", "81"}}, lengths: []int{16, 17, 18, 19}, cvv: 3},
+	Amex:     {ranges: [][2]string{{"34", "34"}, {"37", "37"}}, lengths: []int{15}, cvv: 4, luhn: true},
+	JCB:      {ranges: [][2]string{{"3528", "3589"}}, lengths: []int{16, 17, 18, 19}, cvv: 3, luhn: true},
+}
+
+// DetectBrand returns the brand of a card number by its IIN, or an empty string if it is unknown.
+// Spaces and dashes in the number are ignored.
+func DetectBrand(number string) Brand {
+	number = normalizeNumber(number)
+
+	var (
+		brand   Brand
+		longest int
+	)
+	for b, info := range brands {
+		for _, r := range info.ranges {
+			n := len(r[0])
+			if n <= longest || len(number) < n {
+				continue
+			}
+			if prefix := number[:n]; prefix >= r[0] && prefix <= r[1] {
+				brand, longest = b, n
+			}
+		}
+	}
+	return brand
+}
+
+// CVVLength returns the number of digits of the card security code of a brand, or 0 if the brand is unknown.
+func CVVLength(brand Brand) int {
+	return brands[brand].cvv
+}
+
+func normalizeNumber(number string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(number))
+}
+
+func validLength(info brandInfo, number string) bool {
+	for _, l := range info.lengths {
+		if len(number) == l {
+			return true
+		}
+	}
+	return false
+}
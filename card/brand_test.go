@@ -0,0 +1,26 @@
+package card
+
+import "testing"
+
+func TestDetectBrand(t *testing.T) {
+	tests := []struct {
+		number string
+		brand  Brand
+	}{
+		{"4111111111111111", Visa},
+		{"5555555555554444", Mastercard},
+		{"2223003122003222", Mastercard},
+		{"2201382000000013", MIR},
+		{"6200000000000005", UnionPay},
+		{"378282246310005", Amex},
+		{"3530111333300000", JCB},
+		{"6011111111111117", ""},
+		{"2", ""},
+	}
+
+	for _, test := range tests {
+		if brand := DetectBrand(test.number); brand != test.brand {
+			t.Errorf("%v: got %q, want %q", test.number, brand, test.brand)
+		}
+	}
+}
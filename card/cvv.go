@@ -0,0 +1,46 @@
+package card
+
+import (
+	"regexp"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// reCVV matches a card security code of 3 or 4 digits.
+var reCVV = regexp.MustCompile(`^[0-9]{3,4}$`)
+
+// CVV returns a validation rule that checks a card security code of the given brand: 4 digits for
+// American Express and 3 for the others. If the brand is unknown 3 or 4 digits are accepted.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func CVV(brand Brand) *CVVRule {
+	return &CVVRule{length: CVVLength(brand), code: 3320}
+}
+
+// CVVRule is a validation rule that checks a card security code.
+type CVVRule struct {
+	length int
+	code   int
+}
+
+// Validate checks if the given value is a valid card security code.
+// If its length is wrong, the expected one is returned in the args.
+func (r *CVVRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	if !reCVV.MatchString(s) {
+		code = r.code
+		return
+	}
+	if r.length != 0 && len(s) != r.length {
+		code, args = 3321, []interface{}{r.length}
+	}
+	return
+}
@@ -0,0 +1,32 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCVV(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *CVVRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", CVV(Visa), "", 0, nil},
+		{"visa", CVV(Visa), "123", 0, nil},
+		{"amex", CVV(Amex), "1234", 0, nil},
+		{"visa 4 digits", CVV(Visa), "1234", 3321, []interface{}{3}},
+		{"amex 3 digits", CVV(Amex), "123", 3321, []interface{}{4}},
+		{"unknown brand", CVV(""), "1234", 0, nil},
+		{"too short", CVV(""), "12", 3320, nil},
+		{"letters", CVV(Visa), "12a", 3320, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
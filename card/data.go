@@ -0,0 +1,48 @@
+package card
+
+import (
+	"time"
+)
+
+// Data is a struct-level validator for a payment card: it checks the number, the expiry date
+// and the CVV together, the length of the CVV depending on the brand detected from the number.
+type Data struct {
+	Number   string `json:"number"`
+	ExpMonth int    `json:"expMonth"`
+	// ExpYear is the expiry year, either with four digits or with two.
+	ExpYear int    `json:"expYear"`
+	CVV     string `json:"cvv"`
+	// Brands are the accepted brands, any brand is accepted if it is empty.
+	Brands []Brand `json:"-"`
+	// Now returns the current time, time.Now is used if it is nil.
+	Now func() time.Time `json:"-"`
+}
+
+// Validate checks the card data.
+func (d Data) Validate() (code int, args []interface{}) {
+	_, code, args = d.Check()
+	return
+}
+
+// Check checks the card data and returns the brand detected from the number.
+// The number and the expiry date are required, the CVV is checked if it is not empty.
+func (d Data) Check() (brand Brand, code int, args []interface{}) {
+	if d.Number == "" {
+		code = 3300
+		return
+	}
+	if brand, code, args = Number(d.Brands...).check(d.Number); code != 0 {
+		return
+	}
+
+	now := time.Now()
+	if d.Now != nil {
+		now = d.Now()
+	}
+	if code = checkExpiry(d.ExpMonth, d.ExpYear, now); code != 0 {
+		return
+	}
+
+	code, args = CVV(brand).Validate(d.CVV)
+	return
+}
@@ -0,0 +1,37 @@
+package card
+
+import (
+	"testing"
+	"time"
+)
+
+func TestData(t *testing.T) {
+	now := func() time.Time { return time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		tag   string
+		data  Data
+		brand Brand
+		code  int
+	}{
+		{"valid", Data{Number: "4111111111111111", ExpMonth: 12, ExpYear: 2026, CVV: "123", Now: now}, Visa, 0},
+		{"two-digit year", Data{Number: "378282246310005", ExpMonth: 1, ExpYear: 27, CVV: "1234", Now: now}, Amex, 0},
+		{"without a cvv", Data{Number: "2201382000000013", ExpMonth: 6, ExpYear: 24, Now: now}, MIR, 0},
+		{"no number", Data{ExpMonth: 12, ExpYear: 2026, Now: now}, "", 3300},
+		{"number", Data{Number: "4111111111111112", ExpMonth: 12, ExpYear: 2026, Now: now}, Visa, 3301},
+		{"expired", Data{Number: "4111111111111111", ExpMonth: 5, ExpYear: 2024, Now: now}, Visa, 3311},
+		{"no expiry", Data{Number: "4111111111111111", Now: now}, Visa, 3310},
+		{"amex cvv", Data{Number: "378282246310005", ExpMonth: 1, ExpYear: 27, CVV: "123", Now: now}, Amex, 3321},
+		{"brand", Data{Number: "5555555555554444", ExpMonth: 1, ExpYear: 27, Brands: []Brand{MIR}, Now: now}, Mastercard, 3302},
+	}
+
+	for _, test := range tests {
+		brand, code, _ := test.data.Check()
+		if brand != test.brand || code != test.code {
+			t.Errorf("%s: got %q %v, want %q %v", test.tag, brand, code, test.brand, test.code)
+		}
+		if code, _ := test.data.Validate(); code != test.code {
+			t.Errorf("%s: Validate got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
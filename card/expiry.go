@@ -0,0 +1,72 @@
+package card
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// reExpiry matches an expiry date in the "MM/YY" or "MM/YYYY" form, the slash being optional for "MMYY".
+var reExpiry = regexp.MustCompile(`^(0[1-9]|1[0-2])(?:/|\s*/\s*|)([0-9]{2}|[0-9]{4})$`)
+
+// Expiry returns a validation rule that checks that a card expiry date in the "MM/YY" or "MM/YYYY"
+// form has not passed. A card is valid until the end of its expiry month.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Expiry() *ExpiryRule {
+	return &ExpiryRule{now: time.Now, code: 3310}
+}
+
+// ExpiryRule is a validation rule that checks a card expiry date.
+type ExpiryRule struct {
+	now  func() time.Time
+	code int
+}
+
+// Clock sets the function that returns the current time, it is time.Now by default.
+func (r *ExpiryRule) Clock(now func() time.Time) *ExpiryRule {
+	return &ExpiryRule{now: now, code: r.code}
+}
+
+// Validate checks if the given value is a valid expiry date that has not passed.
+func (r *ExpiryRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	m := reExpiry.FindStringSubmatch(s)
+	if m == nil {
+		code = r.code
+		return
+	}
+	month, _ := strconv.Atoi(m[1])
+	year, _ := strconv.Atoi(m[2])
+
+	code = checkExpiry(month, year, r.now())
+	return
+}
+
+// checkExpiry returns a non-zero code if the month is invalid or the card expired before now.
+// A two-digit year is considered to be in the 21st century.
+func checkExpiry(month, year int, now time.Time) int {
+	if month < 1 || month > 12 || year < 0 {
+		return 3310
+	}
+	if year < 100 {
+		year += 2000
+	}
+
+	// The card expires at the beginning of the month following the expiry month.
+	end := time.Date(year, time.Month(month)+1, 1, 0, 0, 0, 0, now.Location())
+	if !now.Before(end) {
+		return 3311
+	}
+	return 0
+}
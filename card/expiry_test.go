@@ -0,0 +1,39 @@
+package card
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpiry(t *testing.T) {
+	rule := Expiry().Clock(func() time.Time { return time.Date(2024, 6, 30, 23, 59, 0, 0, time.UTC) })
+
+	tests := []struct {
+		value interface{}
+		code  int
+	}{
+		{"", 0},
+		{"06/24", 0},
+		{"06/2024", 0},
+		{"0624", 0},
+		{"06 / 24", 0},
+		{"12/30", 0},
+		{"05/24", 3311},
+		{"12/2023", 3311},
+		{"13/24", 3310},
+		{"00/24", 3310},
+		{"6/24", 3310},
+		{"06/024", 3310},
+		{624, 1005},
+	}
+
+	for _, test := range tests {
+		if code, _ := rule.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+
+	if code, _ := rule.Clock(func() time.Time { return time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC) }).Validate("06/24"); code != 3311 {
+		t.Errorf("the day after the expiry month: got %v", code)
+	}
+}
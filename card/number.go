@@ -0,0 +1,77 @@
+package card
+
+import (
+	"regexp"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+// reNumber matches a card number of 12 to 19 digits.
+var reNumber = regexp.MustCompile(`^[0-9]{12,19}$`)
+
+// Number returns a validation rule that checks a card number. If brands are given, only the cards
+// of these brands are accepted, otherwise any card with a valid number is. The number of a known
+// brand must have one of the lengths of the brand. Spaces and dashes in the number are ignored.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Number(brands ...Brand) *NumberRule {
+	return &NumberRule{brands: brands, code: 3300}
+}
+
+// NumberRule is a validation rule that checks a card number.
+type NumberRule struct {
+	brands []Brand
+	code   int
+}
+
+// Validate checks if the given value is a valid card number.
+func (r *NumberRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	s, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	_, code, args = r.check(s)
+	return
+}
+
+// check validates a card number and returns its brand.
+func (r *NumberRule) check(s string) (brand Brand, code int, args []interface{}) {
+	s = normalizeNumber(s)
+	if !reNumber.MatchString(s) {
+		code = r.code
+		return
+	}
+
+	brand = DetectBrand(s)
+	info, known := brands[brand]
+	switch {
+	case !r.accepts(brand):
+		if !known {
+			return "", 3303, nil
+		}
+		return brand, 3302, []interface{}{brand}
+	case known && !validLength(info, s):
+		return brand, 3304, []interface{}{brand}
+	case (!known || info.luhn) && !checksum.Luhn(s):
+		return brand, 3301, nil
+	}
+	return
+}
+
+func (r *NumberRule) accepts(brand Brand) bool {
+	if len(r.brands) == 0 {
+		return true
+	}
+	for _, b := range r.brands {
+		if b == brand {
+			return true
+		}
+	}
+	return false
+}
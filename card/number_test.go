@@ -0,0 +1,44 @@
+package card
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *NumberRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", Number(), "", 0, nil},
+		{"visa", Number(), "4111111111111111", 0, nil},
+		{"visa 13", Number(), "4222222222222", 0, nil},
+		{"formatted", Number(), "4012 8888-8888 1881", 0, nil},
+		{"mastercard", Number(), "5555555555554444", 0, nil},
+		{"mastercard 2-series", Number(), "2223003122003222", 0, nil},
+		{"mir", Number(), "2201382000000013", 0, nil},
+		{"amex", Number(), "378282246310005", 0, nil},
+		{"jcb", Number(), "3530111333300000", 0, nil},
+		{"unknown brand", Number(), "6011111111111117", 0, nil},
+		{"unionpay without luhn", Number(), "6200000000000006", 0, nil},
+		{"luhn digit off", Number(), "4111111111111112", 3301, nil},
+		{"mir digit off", Number(), "2201382000000014", 3301, nil},
+		{"unknown brand digit off", Number(), "6011111111111118", 3301, nil},
+		{"brand length", Number(), "41111111111111111", 3304, []interface{}{Visa}},
+		{"accepted brands", Number(Visa, MIR), "2201382000000013", 0, nil},
+		{"brand not accepted", Number(Visa, MIR), "5555555555554444", 3302, []interface{}{Mastercard}},
+		{"unknown brand not accepted", Number(Visa), "6011111111111117", 3303, nil},
+		{"too short", Number(), "41111111111", 3300, nil},
+		{"letters", Number(), "4111a11111111111", 3300, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
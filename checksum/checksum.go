@@ -0,0 +1,112 @@
+// Package checksum provides the control digit algorithms shared by the validation rules.
+// The functions expect the input to be already checked for the allowed characters: digits and,
+// where mentioned, upper case letters valued from 10 for "A" to 35 for "Z".
+package checksum
+
+// Luhn checks the Luhn (mod 10) control digit, which is the last digit of s.
+func Luhn(s string) bool {
+	return LuhnBase(s, 10)
+}
+
+// LuhnDigit calculates the Luhn control digit to be appended to s.
+func LuhnDigit(s string) int {
+	return (10 - luhnSum(s, 10, true)%10) % 10
+}
+
+// LuhnBase checks the Luhn control digit of a number in the given base up to 36,
+// e.g. 16 for a hexadecimal MEID written in upper case.
+func LuhnBase(s string, base int) bool {
+	return s != "" && luhnSum(s, base, false)%base == 0
+}
+
+// luhnSum returns the sum of the digits of s, every second digit counting from the right being
+// doubled and the doubled values reduced to the sum of their digits. The rightmost digit is doubled
+// if the control digit is still to be appended. It returns -1 if s contains a digit out of the base.
+func luhnSum(s string, base int, withoutCheck bool) int {
+	sum := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		if !isAlnum(s[i]) || Value(s[i]) >= base {
+			return -1
+		}
+		d := Value(s[i])
+		if ((len(s)-i)%2 == 0) != withoutCheck {
+			if d *= 2; d >= base {
+				d -= base - 1
+			}
+		}
+		sum += d
+	}
+	return sum
+}
+
+// Mod11 checks the mod 11 control character used by ISBN-10 and ISSN: the digits are weighted from
+// the length of s down to 1 and the sum must be divisible by 11. The last character may be "X" for 10.
+func Mod11(s string) bool {
+	if s == "" {
+		return false
+	}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		v := int(s[i] - '0')
+		switch {
+		case i == len(s)-1 && s[i] == 'X':
+			v = 10
+		case s[i] < '0' || s[i] > '9':
+			return false
+		}
+		sum += v * (len(s) - i)
+	}
+	return sum%11 == 0
+}
+
+// Mod97 returns the remainder of the division by 97 of the number made of the characters of s,
+// the letters being replaced with their values as ISO 7064 mod 97-10 requires. It returns -1 if
+// s contains other characters. IBAN, LEI and the like are valid if the remainder is 1.
+func Mod97(s string) int {
+	r := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			r = (r*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			r = (r*100 + int(c-'A'+10)) % 97
+		default:
+			return -1
+		}
+	}
+	return r
+}
+
+// Weighted is a list of weights applied to the characters of a number from the left.
+// If the number is longer than the list, the weights are repeated.
+type Weighted []int
+
+// Sum returns the weighted sum of the characters of s.
+func (w Weighted) Sum(s string) int {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		sum += Value(s[i]) * w[i%len(w)]
+	}
+	return sum
+}
+
+// Mod returns the weighted sum of the characters of s modulo m.
+func (w Weighted) Mod(s string, m int) int {
+	return w.Sum(s) % m
+}
+
+// Value returns the value of a digit or of an upper case letter, "A" being 10. Other characters are valued 0.
+func Value(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	}
+	return 0
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,109 @@
+package checksum
+
+import "testing"
+
+func TestLuhn(t *testing.T) {
+	tests := []struct {
+		value string
+		base  int
+		want  bool
+	}{
+		{"79927398713", 10, true},
+		{"79927398714", 10, false},
+		{"79927398703", 10, false},
+		{"4111111111111111", 10, true},
+		{"0", 10, true},
+		{"", 10, false},
+		{"7992739871A", 10, false},
+		{"A0000000002320", 16, true},
+		{"A0000000002321", 16, false},
+		{"a0000000002320", 16, false},
+		{"G0000000002320", 16, false},
+	}
+
+	for _, test := range tests {
+		if got := LuhnBase(test.value, test.base); got != test.want {
+			t.Errorf("LuhnBase(%q, %v): got %v, want %v", test.value, test.base, got, test.want)
+		}
+		if test.base == 10 {
+			if got := Luhn(test.value); got != test.want {
+				t.Errorf("Luhn(%q): got %v, want %v", test.value, got, test.want)
+			}
+		}
+	}
+
+	if d := LuhnDigit("7992739871"); d != 3 {
+		t.Errorf("LuhnDigit: got %v, want 3", d)
+	}
+	if d := LuhnDigit("411111111111111"); d != 1 {
+		t.Errorf("LuhnDigit: got %v, want 1", d)
+	}
+}
+
+func TestMod11(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"03178471", true},
+		{"03178472", false},
+		{"0306406152", true},
+		{"080442957X", true},
+		{"080442958X", false},
+		{"0X04429570", false},
+		{"", false},
+	}
+
+	for _, test := range tests {
+		if got := Mod11(test.value); got != test.want {
+			t.Errorf("%q: got %v, want %v", test.value, got, test.want)
+		}
+	}
+}
+
+func TestMod97(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{"WEST12345698765432GB82", 1},
+		{"WEST12345698765432GB83", 2},
+		{"370400440532013000DE89", 1},
+		{"", 0},
+		{"12a", -1},
+	}
+
+	for _, test := range tests {
+		if got := Mod97(test.value); got != test.want {
+			t.Errorf("%q: got %v, want %v", test.value, got, test.want)
+		}
+	}
+}
+
+func TestWeighted(t *testing.T) {
+	w := Weighted{1, 2, 3}
+	if got := w.Sum("1111"); got != 7 {
+		t.Errorf("Sum repeats the weights: got %v, want 7", got)
+	}
+	if got := w.Sum("A"); got != 10 {
+		t.Errorf("Sum of a letter: got %v, want 10", got)
+	}
+	if got := w.Mod("999", 11); got != 54%11 {
+		t.Errorf("Mod: got %v, want %v", got, 54%11)
+	}
+}
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		c    byte
+		want int
+	}{
+		{'0', 0}, {'9', 9}, {'A', 10}, {'Z', 35}, {'a', 0}, {'-', 0},
+	}
+
+	for _, test := range tests {
+		if got := Value(test.c); got != test.want {
+			t.Errorf("%q: got %v, want %v", test.c, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"reflect"
+)
+
+const (
+	containsAll = iota
+	containsAny
+	subsetOf
+)
+
+// Contains returns a validation rule that checks if a slice, an array or the keys of a map contain the given value.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Contains(value interface{}) *ContainsRule {
+	return ContainsAll(value)
+}
+
+// ContainsAll returns a validation rule that checks if a slice, an array or the keys of a map contain every given value.
+// The error args contain the values that are missing.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ContainsAll(values ...interface{}) *ContainsRule {
+	return &ContainsRule{
+		values: values,
+		mode:   containsAll,
+		code:   1109,
+	}
+}
+
+// ContainsAny returns a validation rule that checks if a slice, an array or the keys of a map contain
+// at least one of the given values.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ContainsAny(values ...interface{}) *ContainsRule {
+	return &ContainsRule{
+		values: values,
+		mode:   containsAny,
+		code:   1110,
+	}
+}
+
+// SubsetOf returns a validation rule that checks if every element of a slice, an array or every key of a map
+// can be found in the given list of values. The error args contain the values that are not allowed.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SubsetOf(values ...interface{}) *ContainsRule {
+	return &ContainsRule{
+		values: values,
+		set:    newValueSet(values),
+		mode:   subsetOf,
+		code:   1111,
+	}
+}
+
+type ContainsRule struct {
+	values []interface{}
+	set    *valueSet
+	mode   int
+	code   int
+}
+
+// Validate checks if the given value is valid or not.
+func (r *ContainsRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array && rv.Kind() != reflect.Map {
+		code = 1006
+		return
+	}
+	elements := elementsOf(rv, true)
+
+	if r.mode == subsetOf {
+		invalid := make([]interface{}, 0)
+		for _, e := range elements {
+			if !r.set.contains(e.value) {
+				invalid = append(invalid, e.value)
+			}
+		}
+		if len(invalid) > 0 {
+			code, args = r.code, []interface{}{invalid}
+		}
+		return
+	}
+
+	values := make([]interface{}, len(elements))
+	for i := range elements {
+		values[i] = elements[i].value
+	}
+	present := newValueSet(values)
+
+	missing := make([]interface{}, 0)
+	for _, v := range r.values {
+		if !present.contains(v) {
+			missing = append(missing, v)
+		}
+	}
+
+	switch {
+	case r.mode == containsAll && len(missing) > 0:
+		code, args = r.code, []interface{}{missing}
+	case r.mode == containsAny && len(missing) == len(r.values):
+		code, args = r.code, []interface{}{r.values}
+	}
+	return
+}
@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"reflect"
+)
+
+// Equal returns a validation rule that checks if a value is equal to the given one.
+// Values are compared with reflect.DeepEqual, numbers are compared by value regardless of their type.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Equal(value interface{}) *EqualRule {
+	return &EqualRule{
+		value: value,
+		code:  1112,
+	}
+}
+
+// NotEqual returns a validation rule that checks if a value is not equal to the given one.
+// Values are compared with reflect.DeepEqual, numbers are compared by value regardless of their type.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func NotEqual(value interface{}) *EqualRule {
+	return &EqualRule{
+		value: value,
+		not:   true,
+		code:  1113,
+	}
+}
+
+type EqualRule struct {
+	value interface{}
+	not   bool
+	code  int
+}
+
+// Validate checks if the given value is valid or not.
+func (r *EqualRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return
+	}
+
+	expected, _ := Indirect(r.value)
+	if isEqual(value, expected) == r.not {
+		code, args = r.code, []interface{}{expected}
+	}
+	return
+}
+
+func isEqual(a, b interface{}) bool {
+	na, okA := numericKey(a)
+	nb, okB := numericKey(b)
+	if okA && okB {
+		return na == nb
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestEqual(t *testing.T) {
+	one := 1
+
+	tests := []struct {
+		tag   string
+		rule  *EqualRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", Equal("a"), "", 0, nil},
+		{"equal", Equal("a"), "a", 0, nil},
+		{"not equal", Equal("a"), "b", 1112, []interface{}{"a"}},
+		{"numbers of different types", Equal(1), int64(1), 0, nil},
+		{"int and float", Equal(1), 1.0, 0, nil},
+		{"int and fraction", Equal(1), 1.5, 1112, []interface{}{1}},
+		{"string is not a number", Equal(1), "1", 1112, []interface{}{1}},
+		{"pointer", Equal(&one), 1, 0, nil},
+		{"slices", Equal([]int{1, 2}), []int{1, 2}, 0, nil},
+		{"slices differ", Equal([]int{1, 2}), []int{2, 1}, 1112, []interface{}{[]int{1, 2}}},
+		{"not equal rule", NotEqual("admin"), "user", 0, nil},
+		{"not equal rule fails", NotEqual("admin"), "admin", 1113, []interface{}{"admin"}},
+		{"not equal numbers", NotEqual(0.5), float32(0.5), 1113, []interface{}{0.5}},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestStringContent(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *StringContentRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", ContainsString("@"), "", 0, nil},
+		{"contains", ContainsString("@"), "a@b", 0, nil},
+		{"bytes", ContainsString("@"), []byte("a@b"), 0, nil},
+		{"does not contain", ContainsString("@"), "ab", 1310, []interface{}{"@"}},
+		{"prefix", HasPrefix("+7"), "+79001234567", 0, nil},
+		{"no prefix", HasPrefix("+7"), "89001234567", 1311, []interface{}{"+7"}},
+		{"suffix", HasSuffix(".ru"), "mail.ru", 0, nil},
+		{"no suffix", HasSuffix(".ru"), "mail.com", 1312, []interface{}{".ru"}},
+		{"excludes chars", ExcludesChars("<>"), "text", 0, nil},
+		{"contains excluded chars", ExcludesChars("<>"), "<b>", 1313, []interface{}{"<>"}},
+		{"excludes runes", ExcludesRunes('\n', '\t'), "one line", 0, nil},
+		{"contains excluded runes", ExcludesRunes('\n', '\t'), "two\nlines", 1314, []interface{}{"\n\t"}},
+		{"rune class", ContainsRuneClass(unicode.Digit), "pass1", 0, nil},
+		{"no rune of class", ContainsRuneClass(unicode.Digit), "password", 1315, nil},
+		{"not a string", ContainsString("1"), 1, 1005, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
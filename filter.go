@@ -0,0 +1,164 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+type (
+	// Filter is a special rule that transforms a value before the rules following it are applied.
+	// When the value is given as a pointer, the transformed value is written back to it.
+	Filter interface {
+		Rule
+		// Filter returns the transformed value.
+		Filter(value interface{}) interface{}
+	}
+
+	// FilterFunc represents a filter function.
+	// You may wrap it as a Filter by calling FilterBy().
+	FilterFunc func(value interface{}) interface{}
+)
+
+var (
+	// Trim is a filter that removes leading and trailing white space from a string or byte slice.
+	Trim = NewStringFilter(strings.TrimSpace)
+	// Lower is a filter that converts a string or byte slice to lower case.
+	Lower = NewStringFilter(strings.ToLower)
+	// Upper is a filter that converts a string or byte slice to upper case.
+	Upper = NewStringFilter(strings.ToUpper)
+	// NormalizeNFC is a filter that converts a string or byte slice to the Unicode normalization form C.
+	NormalizeNFC = NewStringFilter(norm.NFC.String)
+	// NormalizeNFKC is a filter that converts a string or byte slice to the Unicode normalization form KC,
+	// so that, for example, full-width digits become ASCII digits.
+	NormalizeNFKC = NewStringFilter(norm.NFKC.String)
+	// StripNonDigits is a filter that removes everything but digits from a string or byte slice.
+	// Full-width digits are converted to ASCII digits.
+	StripNonDigits = NewStringFilter(stripNonDigits)
+	// CollapseSpaces is a filter that trims a string or byte slice and replaces every sequence of white space with a single space.
+	CollapseSpaces = NewStringFilter(collapseSpaces)
+)
+
+// StringFilter is a filter that transforms a string or byte slice using the specified function.
+// Values of other types are left intact.
+type StringFilter struct {
+	f func(string) string
+}
+
+// NewStringFilter creates a new filter using a function that takes a string value and returns the transformed one.
+func NewStringFilter(f func(string) string) *StringFilter {
+	return &StringFilter{f: f}
+}
+
+// Filter returns the transformed value.
+func (r *StringFilter) Filter(value interface{}) interface{} {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.String {
+		return reflect.ValueOf(r.f(rv.String())).Convert(rv.Type()).Interface()
+	}
+	if rv.Kind() == reflect.Slice && rv.Type() == bytesType {
+		return []byte(r.f(string(rv.Bytes())))
+	}
+	return value
+}
+
+// Validate always succeeds, filters never fail.
+func (r *StringFilter) Validate(interface{}) (code int, args []interface{}) {
+	return 0, nil
+}
+
+type inlineFilter struct {
+	f FilterFunc
+}
+
+func (r *inlineFilter) Filter(value interface{}) interface{} {
+	return r.f(value)
+}
+
+func (r *inlineFilter) Validate(interface{}) (code int, args []interface{}) {
+	return 0, nil
+}
+
+// FilterBy wraps a FilterFunc into a Filter.
+func FilterBy(f FilterFunc) Filter {
+	return &inlineFilter{f}
+}
+
+// applyFilter transforms the value with the filter. If the value is a pointer, the transformed value is written
+// back to the variable it points to and the pointer itself is returned.
+func applyFilter(f Filter, value interface{}) interface{} {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return f.Filter(value)
+	}
+
+	elem := rv.Elem()
+	if elem.Kind() == reflect.Ptr {
+		applyFilter(f, elem.Interface())
+		return value
+	}
+
+	setFiltered(elem, f.Filter(elem.Interface()))
+	return value
+}
+
+// setFiltered stores the transformed value in the variable if their types are compatible.
+func setFiltered(elem reflect.Value, value interface{}) {
+	filtered := reflect.ValueOf(value)
+	if elem.CanSet() && filtered.IsValid() && filtered.Kind() == elem.Kind() && filtered.Type().ConvertibleTo(elem.Type()) {
+		elem.Set(filtered.Convert(elem.Type()))
+	}
+}
+
+// fieldFilter is a filter that also writes the transformed value to the struct field it is bound to,
+// so that the rules following it get the value itself rather than a pointer to the field.
+type fieldFilter struct {
+	filter   Filter
+	fieldPtr reflect.Value
+}
+
+func (r *fieldFilter) Filter(value interface{}) interface{} {
+	filtered := r.filter.Filter(value)
+	setFiltered(r.fieldPtr.Elem(), filtered)
+	return filtered
+}
+
+func (r *fieldFilter) Validate(interface{}) (code int, args []interface{}) {
+	return 0, nil
+}
+
+// bindFilters returns the rules with every filter bound to the field the pointer refers to.
+func bindFilters(rules []Rule, fieldPtr reflect.Value) []Rule {
+	bound := make([]Rule, len(rules))
+	for i, rule := range rules {
+		if f, ok := rule.(Filter); ok {
+			rule = &fieldFilter{filter: f, fieldPtr: fieldPtr}
+		}
+		bound[i] = rule
+	}
+	return bound
+}
+
+// hasFilter checks if any of the rules is a filter.
+func hasFilter(rules []Rule) bool {
+	for _, rule := range rules {
+		if _, ok := rule.(Filter); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func stripNonDigits(s string) string {
+	return strings.Map(func(c rune) rune {
+		if c >= '0' && c <= '9' {
+			return c
+		}
+		return -1
+	}, norm.NFKC.String(s))
+}
+
+func collapseSpaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
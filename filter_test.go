@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"testing"
+)
+
+type filterTestCustomer struct {
+	Name     string
+	Phone    string
+	Nickname *string
+	Code     []byte
+}
+
+func TestFilters(t *testing.T) {
+	tests := []struct {
+		tag    string
+		filter Filter
+		value  interface{}
+		want   interface{}
+	}{
+		{"trim", Trim, "  a b  ", "a b"},
+		{"lower", Lower, "ABC", "abc"},
+		{"upper", Upper, "abc", "ABC"},
+		{"bytes", Upper, []byte("abc"), []byte("ABC")},
+		{"NFC", NormalizeNFC, "e\u0301", "\u00e9"},
+		{"NFKC", NormalizeNFKC, "１２", "12"},
+		{"strip non-digits", StripNonDigits, "+7 (900) 123-45-67", "79001234567"},
+		{"strip full-width", StripNonDigits, "１-２", "12"},
+		{"collapse spaces", CollapseSpaces, " a \t b\n c ", "a b c"},
+		{"not a string", Trim, 42, 42},
+		{"filter by", FilterBy(func(v interface{}) interface{} { return v.(int) * 2 }), 21, 42},
+	}
+
+	for _, test := range tests {
+		got := test.filter.Filter(test.value)
+		if b, ok := got.([]byte); ok {
+			got = string(b)
+		}
+		want := test.want
+		if b, ok := want.([]byte); ok {
+			want = string(b)
+		}
+		if got != want {
+			t.Errorf("%s: got %#v, want %#v", test.tag, got, want)
+		}
+	}
+}
+
+func TestFilterWritesBack(t *testing.T) {
+	s := "  Ivan  "
+	if err := Validate(&s, Trim, Length(1, 4)); err != nil {
+		t.Errorf("Validate: unexpected error %v", err)
+	}
+	if s != "Ivan" {
+		t.Errorf("Validate: got %q, want %q", s, "Ivan")
+	}
+}
+
+func TestFilterInStruct(t *testing.T) {
+	nickname := "  Vanya "
+	c := filterTestCustomer{Name: "  Ivan  ", Phone: "+7 (900) 123-45-67", Nickname: &nickname, Code: []byte(" ab ")}
+
+	var name interface{}
+	err := ValidateStruct(&c,
+		Field(&c.Name, Trim, By(func(v interface{}) (int, []interface{}) {
+			// the rules following a filter get the value itself, not a pointer to the field
+			name = v.(string)
+			return 0, nil
+		}), Length(1, 4)),
+		Field(&c.Phone, StripNonDigits, Length(11, 11)),
+		Field(&c.Nickname, Trim, Length(1, 5)),
+		Field(&c.Code, Trim, Upper),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if name != "Ivan" {
+		t.Errorf("rule after the filter: got %#v", name)
+	}
+	if c.Name != "Ivan" || c.Phone != "79001234567" || *c.Nickname != "Vanya" || string(c.Code) != "AB" {
+		t.Errorf("filtered values are not written back: %+v %q", c, *c.Nickname)
+	}
+}
+
+func TestFilterInStructFails(t *testing.T) {
+	c := filterTestCustomer{Name: "  Ivan Ivanov  "}
+	err := ValidateStruct(&c, Field(&c.Name, CollapseSpaces, Length(1, 4)))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if c.Name != "Ivan Ivanov" {
+		t.Errorf("got %q", c.Name)
+	}
+}
@@ -1,19 +1,78 @@
 package validation
 
+import (
+	"io"
+	"time"
+)
+
 // In returns a validation rule that checks if a value can be found in the given list of values.
-// Note that the value being checked and the possible range of values must be of the same type.
+// Note that the value being checked and the possible range of values must be of the same type,
+// unless the comparison is relaxed by calling Numeric, FoldCase or Normalize.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func In(values ...interface{}) *InRule {
 	return &InRule{
-		elements: values,
-		code:     1101,
+		set:  newValueSet(values),
+		code: 1101,
+	}
+}
+
+// InFromReader returns an In rule with the values read from the reader, one value per line.
+// Empty lines and lines starting with # are ignored.
+func InFromReader(r io.Reader) (*InRule, error) {
+	values, err := readValues(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return In(values...), nil
+}
+
+// InFromFile returns an In rule with the values read from the file, one value per line.
+// Empty lines and lines starting with # are ignored.
+func InFromFile(path string) (*InRule, error) {
+	values, err := readValuesFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return In(values...), nil
+}
+
+// InFromProvider returns an In rule with the values returned by the provider.
+// The values are requested again when they are older than refresh. A zero refresh means the values are loaded once.
+func InFromProvider(provider func() []interface{}, refresh time.Duration) *InRule {
+	return &InRule{
+		set:  newProvidedValueSet(provider, refresh),
+		code: 1101,
 	}
 }
 
 type InRule struct {
-	elements []interface{}
-	message  string
-	code     int
+	set     *valueSet
+	message string
+	code    int
+}
+
+// Numeric makes the rule compare numbers by value regardless of their type, so that int64(1) is found in In(1, 2, 3).
+// Strings holding decimal numbers, such as the values read from a file, are compared as numbers too.
+func (r *InRule) Numeric() *InRule {
+	r.set.configure(func(s *valueSet) { s.numeric = true })
+
+	return r
+}
+
+// FoldCase makes the rule compare strings case-insensitively.
+func (r *InRule) FoldCase() *InRule {
+	r.set.configure(func(s *valueSet) { s.foldCase = true })
+
+	return r
+}
+
+// Normalize makes the rule compare strings in the Unicode normalization form C.
+func (r *InRule) Normalize() *InRule {
+	r.set.configure(func(s *valueSet) { s.normalize = true })
+
+	return r
 }
 
 // Validate checks if the given value is valid or not.
@@ -23,10 +82,8 @@ func (r *InRule) Validate(value interface{}) (code int, args []interface{}) {
 		return
 	}
 
-	for _, e := range r.elements {
-		if e == value {
-			return
-		}
+	if r.set.contains(value) {
+		return
 	}
 	code = r.code
 	return
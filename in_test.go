@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type inTestPair struct {
+	Name  string
+	Value interface{}
+}
+
+func TestIn(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *InRule
+		value interface{}
+		code  int
+	}{
+		{"empty", In(1, 2), 0, 0},
+		{"found", In(1, 2, 3), 2, 0},
+		{"not found", In(1, 2, 3), 4, 1101},
+		{"different type", In(1, 2, 3), int64(2), 1101},
+		{"numeric", In(1, 2, 3).Numeric(), int64(2), 0},
+		{"numeric uint", In(1, 2, 3).Numeric(), uint8(3), 0},
+		{"numeric float", In(1, 2, 3).Numeric(), 2.0, 0},
+		{"numeric fraction", In(1, 2, 3).Numeric(), 2.5, 1101},
+		{"numeric string", In(1, 2, 3).Numeric(), "3", 0},
+		{"numeric not a number", In(1, 2, 3).Numeric(), "three", 1101},
+		{"numeric NaN string", In("NaN").Numeric(), "NaN", 0},
+		{"case", In("Moscow"), "moscow", 1101},
+		{"fold case", In("Moscow").FoldCase(), "MOSCOW", 0},
+		{"fold case not found", In("Moscow").FoldCase(), "Kazan", 1101},
+		{"not normalized", In("e\u0301"), "\u00e9", 1101},
+		{"normalize", In("e\u0301").Normalize(), "\u00e9", 0},
+		{"slice", In([]int{1, 2}, []int{3}), []int{3}, 0},
+		{"slice not found", In([]int{1, 2}), []int{3}, 1101},
+		{"struct with slice", In(inTestPair{"a", []int{1}}), inTestPair{"a", []int{1}}, 0},
+		{"struct with slice not found", In(inTestPair{"a", []int{1}}), inTestPair{"a", []int{2}}, 1101},
+		{"struct with map", In(inTestPair{"a", 1}), inTestPair{"a", map[string]int{"a": 1}}, 1101},
+		{"array with slice", In([1]interface{}{[]int{1}}), [1]interface{}{[]int{1}}, 0},
+		{"struct", In(inTestPair{"a", 1}, inTestPair{"b", 2}), inTestPair{"b", 2}, 0},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestInFromReader(t *testing.T) {
+	r, err := InFromReader(strings.NewReader("# codes\n 10 \n\n20\n30\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code, _ := r.Validate("20"); code != 0 {
+		t.Errorf("20: got %v", code)
+	}
+	if code, _ := r.Validate(20); code != 1101 {
+		t.Errorf("int 20 without Numeric: got %v", code)
+	}
+
+	r.Numeric()
+	for _, value := range []interface{}{10, int64(20), uint(30), 30.0, "10"} {
+		if code, _ := r.Validate(value); code != 0 {
+			t.Errorf("%#v: got %v", value, code)
+		}
+	}
+	if code, _ := r.Validate(40); code != 1101 {
+		t.Errorf("40: got %v", code)
+	}
+
+	if _, err := InFromFile("testdata/does-not-exist"); err == nil {
+		t.Error("missing file: expected an error")
+	}
+}
+
+func TestInFromProvider(t *testing.T) {
+	values := []interface{}{"a"}
+	r := InFromProvider(func() []interface{} { return values }, time.Nanosecond)
+	if code, _ := r.Validate("a"); code != 0 {
+		t.Errorf("a: got %v", code)
+	}
+
+	values = []interface{}{"b"}
+	time.Sleep(time.Millisecond)
+	if code, _ := r.Validate("a"); code != 1101 {
+		t.Errorf("a after the refresh: got %v", code)
+	}
+	if code, _ := r.Validate("b"); code != 0 {
+		t.Errorf("b after the refresh: got %v", code)
+	}
+}
+
+func TestNotIn(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *NotInRule
+		value interface{}
+		code  int
+	}{
+		{"empty", NotIn(1, 2), 0, 0},
+		{"absent", NotIn(1, 2, 3), 4, 0},
+		{"present", NotIn(1, 2, 3), 3, 1107},
+		{"numeric", NotIn(1, 2, 3).Numeric(), "2", 1107},
+		{"fold case", NotIn("admin").FoldCase(), "Admin", 1107},
+		{"normalize", NotIn("\u00e9").Normalize(), "e\u0301", 1107},
+		{"struct with slice", NotIn(inTestPair{"a", []int{1}}), inTestPair{"a", []int{1}}, 1107},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package is
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+var (
+	// EAN8 validates if a string is an EAN-8 barcode with a valid check digit
+	EAN8 = validation.NewStringRule(isGTINOf(8), 3401)
+	// EAN13 validates if a string is an EAN-13 barcode with a valid check digit
+	EAN13 = validation.NewStringRule(isGTINOf(13), 3402)
+	// UPCA validates if a string is a 12-digit UPC-A barcode with a valid check digit
+	UPCA = validation.NewStringRule(isGTINOf(12), 3403)
+	// UPCE validates if a string is an 8-digit UPC-E barcode whose UPC-A expansion has a valid check digit
+	UPCE = validation.NewStringRule(isUPCE, 3404)
+	// GTIN14 validates if a string is a GTIN-14 with a valid check digit
+	GTIN14 = validation.NewStringRule(isGTINOf(14), 3405)
+	// GTIN validates if a string is a GTIN-8, GTIN-12, GTIN-13 or GTIN-14 with a valid check digit
+	GTIN = validation.NewStringRule(isGTIN, 3406)
+	// ISSN validates if a string is an ISSN, with or without the hyphen (e.g. "0317-8471")
+	ISSN = validation.NewStringRule(isISSN, 3410)
+	// ISMN validates if a string is an ISMN, either the 13-digit one starting with 979-0 or the old "M" one
+	ISMN = validation.NewStringRule(isISMN, 3411)
+	// ISIN validates if a string is an International Securities Identification Number
+	ISIN = validation.NewStringRule(isISIN, 3420)
+	// CUSIP validates if a string is a 9-character CUSIP
+	CUSIP = validation.NewStringRule(isCUSIP, 3421)
+	// SEDOL validates if a string is a 7-character SEDOL
+	SEDOL = validation.NewStringRule(isSEDOL, 3422)
+)
+
+var (
+	reGTIN  = regexp.MustCompile(`^([0-9]{8}|[0-9]{12,14})$`)
+	reUPCE  = regexp.MustCompile(`^[01][0-9]{7}$`)
+	reISSN  = regexp.MustCompile(`^[0-9]{4}-?[0-9]{3}[0-9X]$`)
+	reISMN  = regexp.MustCompile(`^(9790[0-9]{9}|M[0-9]{9})$`)
+	reISIN  = regexp.MustCompile(`^[A-Z]{2}[0-9A-Z]{9}[0-9]$`)
+	reCUSIP = regexp.MustCompile(`^[0-9A-Z*@#]{8}[0-9]$`)
+	reSEDOL = regexp.MustCompile(`^[0-9B-DF-HJ-NP-TV-Z]{6}[0-9]$`)
+
+	// sedolWeights are the weights of the characters of a SEDOL.
+	sedolWeights = checksum.Weighted{1, 3, 1, 7, 3, 9, 1}
+	// barcodeSeparators are removed from a barcode before it is checked.
+	barcodeSeparators = strings.NewReplacer(" ", "", "-", "")
+)
+
+// ExpandUPCE returns the 12-digit UPC-A code a UPC-E one is the compressed form of,
+// or an empty string if the value is not an 8-digit UPC-E code.
+func ExpandUPCE(value string) string {
+	if !reUPCE.MatchString(value) {
+		return ""
+	}
+	ns, d, check := value[:1], value[1:7], value[7:]
+
+	switch last := d[5]; {
+	case last <= '2':
+		return ns + d[:2] + d[5:] + "0000" + d[2:5] + check
+	case last == '3':
+		return ns + d[:3] + "00000" + d[3:5] + check
+	case last == '4':
+		return ns + d[:4] + "00000" + d[4:5] + check
+	default:
+		return ns + d[:5] + "0000" + d[5:] + check
+	}
+}
+
+func isGTINOf(length int) func(string) bool {
+	return func(value string) bool {
+		value = barcodeSeparators.Replace(value)
+		return len(value) == length && isGTIN(value)
+	}
+}
+
+// isGTIN checks the GS1 check digit: counting from the right, the digits preceding it are weighted 3 and 1 alternately.
+func isGTIN(value string) bool {
+	value = barcodeSeparators.Replace(value)
+	if !reGTIN.MatchString(value) {
+		return false
+	}
+	weights := checksum.Weighted{3, 1}
+	if len(value)%2 == 1 {
+		weights = checksum.Weighted{1, 3}
+	}
+	return weights.Mod(value, 10) == 0
+}
+
+func isUPCE(value string) bool {
+	upca := ExpandUPCE(value)
+	return upca != "" && isGTIN(upca)
+}
+
+func isISSN(value string) bool {
+	return reISSN.MatchString(value) && checksum.Mod11(strings.Replace(value, "-", "", 1))
+}
+
+func isISMN(value string) bool {
+	value = barcodeSeparators.Replace(value)
+	if !reISMN.MatchString(value) {
+		return false
+	}
+	// The old ISMN is the 13-digit one with the "M" replaced with "9790".
+	return isGTIN(strings.Replace(value, "M", "9790", 1))
+}
+
+// isISIN checks the Luhn check digit of the ISIN whose letters are replaced with their two-digit values.
+func isISIN(value string) bool {
+	if !reISIN.MatchString(value) {
+		return false
+	}
+	var digits strings.Builder
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; c >= 'A' && c <= 'Z' {
+			digits.WriteString(strconv.Itoa(checksum.Value(c)))
+		} else {
+			digits.WriteByte(c)
+		}
+	}
+	return checksum.Luhn(digits.String())
+}
+
+func isCUSIP(value string) bool {
+	if !reCUSIP.MatchString(value) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		v := strings.IndexByte("*@#", value[i]) + 36
+		if v < 36 {
+			v = checksum.Value(value[i])
+		}
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	return (10-sum%10)%10 == int(value[8]-'0')
+}
+
+func isSEDOL(value string) bool {
+	return reSEDOL.MatchString(value) && sedolWeights.Mod(value, 10) == 0
+}
@@ -0,0 +1,84 @@
+package is
+
+import (
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestCodes(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value string
+		code  int
+	}{
+		{"EAN8 empty", EAN8, "", 0},
+		{"EAN8", EAN8, "96385074", 0},
+		{"EAN8 off", EAN8, "96385075", 3401},
+		{"EAN8 length", EAN8, "4006381333931", 3401},
+		{"EAN13", EAN13, "4006381333931", 0},
+		{"EAN13 formatted", EAN13, "590-1234-12345-7", 0},
+		{"EAN13 off", EAN13, "4006381333932", 3402},
+		{"EAN13 swapped", EAN13, "4006383133931", 3402},
+		{"UPCA", UPCA, "036000291452", 0},
+		{"UPCA off", UPCA, "036000291453", 3403},
+		{"UPCE", UPCE, "04252614", 0},
+		{"UPCE other expansion", UPCE, "01234565", 0},
+		{"UPCE off", UPCE, "04252615", 3404},
+		{"UPCE number system", UPCE, "24252614", 3404},
+		{"GTIN14", GTIN14, "10012345678902", 0},
+		{"GTIN14 off", GTIN14, "10012345678903", 3405},
+		{"GTIN 8", GTIN, "73513537", 0},
+		{"GTIN 12", GTIN, "012345678905", 0},
+		{"GTIN 13", GTIN, "5901234123457", 0},
+		{"GTIN 14", GTIN, "10012345678902", 0},
+		{"GTIN 10", GTIN, "0123456789", 3406},
+		{"ISSN", ISSN, "0317-8471", 0},
+		{"ISSN without the hyphen", ISSN, "03178471", 0},
+		{"ISSN X", ISSN, "2049-3630", 0},
+		{"ISSN off", ISSN, "0317-8472", 3410},
+		{"ISMN", ISMN, "979-0-2600-0043-8", 0},
+		{"ISMN old", ISMN, "M-2306-7118-7", 0},
+		{"ISMN off", ISMN, "979-0-2600-0043-9", 3411},
+		{"ISMN old off", ISMN, "M-2306-7118-8", 3411},
+		{"ISMN prefix", ISMN, "978-0-2600-0043-8", 3411},
+		{"ISIN", ISIN, "US0378331005", 0},
+		{"ISIN with letters", ISIN, "AU0000XVGZA3", 0},
+		{"ISIN off", ISIN, "US0378331006", 3420},
+		{"ISIN lower case", ISIN, "us0378331005", 3420},
+		{"CUSIP", CUSIP, "037833100", 0},
+		{"CUSIP with a letter", CUSIP, "38259P508", 0},
+		{"CUSIP off", CUSIP, "037833101", 3421},
+		{"SEDOL", SEDOL, "0263494", 0},
+		{"SEDOL with letters", SEDOL, "B0YBKJ7", 0},
+		{"SEDOL off", SEDOL, "0263495", 3422},
+		{"SEDOL vowel", SEDOL, "B0YAKJ7", 3422},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestExpandUPCE(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"04252614", "042100005264"},
+		{"01234565", "012345000065"},
+		{"01234531", "012300000451"},
+		{"01234543", "012340000053"},
+		{"0123456", ""},
+		{"21234565", ""},
+	}
+
+	for _, test := range tests {
+		if got := ExpandUPCE(test.value); got != test.want {
+			t.Errorf("%v: got %v, want %v", test.value, got, test.want)
+		}
+	}
+}
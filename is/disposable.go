@@ -0,0 +1,89 @@
+package is
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"sync"
+)
+
+// defaultDisposableDomains are the domains of well-known disposable email services.
+const defaultDisposableDomains = `
+	10minutemail.com 10minutemail.net 1secmail.com 1secmail.net 1secmail.org 20minutemail.com
+	33mail.com anonbox.net armyspy.com burnermail.io byom.de cuvox.de dayrep.com discard.email
+	dispostable.com dropmail.me einrot.com emailfake.com emailondeck.com emltmp.com fakeinbox.com
+	fleckens.hu generator.email getairmail.com getnada.com grr.la guerrillamail.biz guerrillamail.com
+	guerrillamail.de guerrillamail.net guerrillamail.org guerrillamailblock.com gustr.com
+	harakirimail.com inboxkitten.com incognitomail.org jetable.org jourrapide.com mailcatch.com
+	maildrop.cc mailexpire.com mailforspam.com mailinator.com mailinator.net mailnesia.com
+	mailpoof.com mailsac.com mailtemp.info minuteinbox.com mintemail.com moakt.com mohmal.com
+	mvrht.com mytemp.email nada.email rhyta.com sharklasers.com spam4.me spambox.us spamdecoy.net
+	spamfree24.org spamgourmet.com superrito.com teleworm.us temp-mail.io temp-mail.org tempail.com
+	tempinbox.com tempmail.com tempmailo.com temporary-mail.net tempr.email throwawaymail.com
+	tmpmail.net tmpmail.org trashmail.com trashmail.de trashmail.net trbvm.com wegwerfmail.de
+	yopmail.com yopmail.fr yopmail.net
+`
+
+var disposableDomains = struct {
+	sync.RWMutex
+	set map[string]bool
+}{set: domainSet(strings.Fields(defaultDisposableDomains))}
+
+// SetDisposableDomains replaces the list of disposable email domains used by EmailRule.DenyDisposable().
+// Internationalized domain names may be given in either form.
+func SetDisposableDomains(domains ...string) {
+	set := domainSet(domains)
+
+	disposableDomains.Lock()
+	disposableDomains.set = set
+	disposableDomains.Unlock()
+}
+
+// LoadDisposableDomains replaces the list of disposable email domains with the one read from a file
+// containing a domain per line. Empty lines and lines starting with "#" are skipped.
+func LoadDisposableDomains(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var domains []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			domains = append(domains, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	SetDisposableDomains(domains...)
+	return nil
+}
+
+// isDisposableDomain checks if the domain or one of its parent domains is a disposable one.
+func isDisposableDomain(domain string) bool {
+	disposableDomains.RLock()
+	defer disposableDomains.RUnlock()
+
+	for {
+		if disposableDomains.set[domain] {
+			return true
+		}
+		i := strings.IndexByte(domain, '.')
+		if i < 0 {
+			return false
+		}
+		domain = domain[i+1:]
+	}
+}
+
+func domainSet(domains []string) map[string]bool {
+	set := make(map[string]bool, len(domains))
+	for _, d := range asciiDomains(domains) {
+		set[d] = true
+	}
+	return set
+}
@@ -0,0 +1,43 @@
+package is
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDisposableDomains(t *testing.T) {
+	defer SetDisposableDomains(strings.Fields(defaultDisposableDomains)...)
+
+	SetDisposableDomains("Spam.example.", "почта.рф")
+	tests := []struct {
+		domain     string
+		disposable bool
+	}{
+		{"spam.example", true},
+		{"a.spam.example", true},
+		{"example", false},
+		{"xn--80a1acny.xn--p1ai", true},
+		{"mailinator.com", false},
+	}
+	for _, test := range tests {
+		if got := isDisposableDomain(test.domain); got != test.disposable {
+			t.Errorf("%v: got %v, want %v", test.domain, got, test.disposable)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "domains.txt")
+	if err := ioutil.WriteFile(path, []byte("# disposable\n\n  trash.example  \n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadDisposableDomains(path); err != nil {
+		t.Fatal(err)
+	}
+	if !isDisposableDomain("trash.example") || isDisposableDomain("spam.example") || isDisposableDomain("# disposable") {
+		t.Error("the loaded list must replace the previous one")
+	}
+	if err := LoadDisposableDomains(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("missing file: expected an error")
+	}
+}
@@ -0,0 +1,201 @@
+package is
+
+import (
+	"context"
+	"net"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// The length limits of an email address (RFC 5321).
+const (
+	maxEmailLocalLength  = 64
+	maxEmailDomainLength = 253
+	maxEmailLength       = 254
+)
+
+// MXResolver looks up the mail exchangers and the addresses of domains. *net.Resolver implements it.
+type MXResolver interface {
+	Resolver
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// reEmailLabel matches a label of the ASCII form of a domain name.
+var reEmailLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// EmailRule is a validation rule that checks an email address.
+type EmailRule struct {
+	displayName  bool
+	noPlus       bool
+	disposable   bool
+	allowDomains []string
+	denyDomains  []string
+	resolver     MXResolver
+	timeout      time.Duration
+	code         int
+	nameCode     int
+	lengthCode   int
+	plusCode     int
+	domainCode   int
+	disposeCode  int
+	mxCode       int
+}
+
+// EmailAddress returns a validation rule that checks an email address parsed with net/mail.
+// Internationalized domain names are checked in their punycode form, and the local part, the domain
+// and the whole address must not exceed the RFC 5321 length limits. Call the methods of the rule to
+// allow display names, forbid plus-addressing, restrict the domains or check that the domain accepts mail.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func EmailAddress() *EmailRule {
+	return &EmailRule{
+		timeout:     DefaultResolveTimeout,
+		code:        3900,
+		nameCode:    3901,
+		lengthCode:  3902,
+		plusCode:    3903,
+		domainCode:  3904,
+		disposeCode: 3905,
+		mxCode:      3906,
+	}
+}
+
+// AllowDisplayName allows the address to be given with a display name, e.g. "John Doe <john@example.com>".
+func (r *EmailRule) AllowDisplayName() *EmailRule {
+	r.displayName = true
+
+	return r
+}
+
+// DisallowPlus forbids the plus-addressing, e.g. "john+news@example.com".
+func (r *EmailRule) DisallowPlus() *EmailRule {
+	r.noPlus = true
+
+	return r
+}
+
+// AllowDomains sets the only domains allowed. A domain starting with "*." matches any subdomain of the domain.
+// Internationalized domain names may be given in either form.
+func (r *EmailRule) AllowDomains(domains ...string) *EmailRule {
+	r.allowDomains = asciiDomains(domains)
+
+	return r
+}
+
+// DenyDomains sets the domains that are not allowed. A domain starting with "*." matches any subdomain of the domain.
+// Internationalized domain names may be given in either form.
+func (r *EmailRule) DenyDomains(domains ...string) *EmailRule {
+	r.denyDomains = asciiDomains(domains)
+
+	return r
+}
+
+// DenyDisposable forbids the domains of disposable email services. The list of the domains can be replaced
+// by calling SetDisposableDomains() or LoadDisposableDomains().
+func (r *EmailRule) DenyDisposable() *EmailRule {
+	r.disposable = true
+
+	return r
+}
+
+// Resolver sets the resolver used to check that the domain has a mail exchanger or, failing that, an address,
+// and the time the lookup may take. A zero timeout means DefaultResolveTimeout.
+func (r *EmailRule) Resolver(resolver MXResolver, timeout time.Duration) *EmailRule {
+	r.resolver = resolver
+	if r.timeout = timeout; timeout == 0 {
+		r.timeout = DefaultResolveTimeout
+	}
+
+	return r
+}
+
+// Validate checks if the given value is an email address that satisfies the rule.
+func (r *EmailRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	str, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	addr, err := mail.ParseAddress(str)
+	if err != nil {
+		code = r.code
+		return
+	}
+	if !r.displayName && (addr.Name != "" || strings.TrimSpace(str) != addr.Address) {
+		code = r.nameCode
+		return
+	}
+
+	at := strings.LastIndexByte(addr.Address, '@')
+	local, domain := addr.Address[:at], strings.ToLower(strings.TrimSuffix(addr.Address[at+1:], "."))
+	domain = toASCIIDomain(domain)
+
+	switch {
+	case len(local) > maxEmailLocalLength:
+		return r.lengthCode, []interface{}{"local_part", maxEmailLocalLength}
+	case len(domain) > maxEmailDomainLength:
+		return r.lengthCode, []interface{}{"domain", maxEmailDomainLength}
+	case len(local)+1+len(domain) > maxEmailLength:
+		return r.lengthCode, []interface{}{"address", maxEmailLength}
+	case !isEmailDomain(domain):
+		code = r.code
+		return
+	case r.noPlus && strings.ContainsRune(local, '+'):
+		code = r.plusCode
+		return
+	case len(r.allowDomains) > 0 && !matchHosts(r.allowDomains, domain) || matchHosts(r.denyDomains, domain):
+		return r.domainCode, []interface{}{domain}
+	case r.disposable && isDisposableDomain(domain):
+		return r.disposeCode, []interface{}{domain}
+	}
+
+	if r.resolver != nil && !r.acceptsMail(domain) {
+		return r.mxCode, []interface{}{domain}
+	}
+	return
+}
+
+// acceptsMail checks that the domain has a mail exchanger, or an address used as the implicit one (RFC 5321).
+// A domain publishing the null MX record (RFC 7505) does not accept mail.
+func (r *EmailRule) acceptsMail(domain string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	if mxs, err := r.resolver.LookupMX(ctx, domain); err == nil && len(mxs) > 0 {
+		return !(len(mxs) == 1 && (mxs[0].Host == "." || mxs[0].Host == ""))
+	}
+	addrs, err := r.resolver.LookupIPAddr(ctx, domain)
+	return err == nil && len(addrs) > 0
+}
+
+// isEmailDomain checks the ASCII form of a domain: at least two labels of letters, digits and hyphens,
+// the top-level one not being numeric.
+func isEmailDomain(domain string) bool {
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if !reEmailLabel.MatchString(label) {
+			return false
+		}
+	}
+	return !isDigit(labels[len(labels)-1])
+}
+
+// asciiDomains converts the domains into the lower-case ASCII form the domain of an address is compared in.
+func asciiDomains(domains []string) []string {
+	ascii := make([]string, len(domains))
+	for i, d := range domains {
+		ascii[i] = toASCIIDomain(strings.TrimSuffix(strings.ToLower(d), "."))
+	}
+	return ascii
+}
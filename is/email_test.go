@@ -0,0 +1,82 @@
+package is
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// emailTestResolver resolves the mail exchangers and the addresses of the domains from maps.
+type emailTestResolver struct {
+	urlTestResolver
+	mx map[string][]string
+}
+
+func (r emailTestResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	hosts, ok := r.mx[name]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	mxs := make([]*net.MX, len(hosts))
+	for i, host := range hosts {
+		mxs[i] = &net.MX{Host: host, Pref: 10}
+	}
+	return mxs, nil
+}
+
+func TestEmailAddress(t *testing.T) {
+	resolver := emailTestResolver{
+		urlTestResolver: urlTestResolver{"a-only.com": {"93.184.216.34"}},
+		mx:              map[string][]string{"example.com": {"mx.example.com."}, "null.com": {"."}},
+	}
+
+	tests := []struct {
+		tag   string
+		rule  *EmailRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", EmailAddress(), "", 0, nil},
+		{"address", EmailAddress(), "john@example.com", 0, nil},
+		{"upper case domain", EmailAddress(), "john@EXAMPLE.com", 0, nil},
+		{"idn", EmailAddress(), "john@пример.рф", 0, nil},
+		{"no domain", EmailAddress(), "john@", 3900, nil},
+		{"no at", EmailAddress(), "john.example.com", 3900, nil},
+		{"single label", EmailAddress(), "john@localhost", 3900, nil},
+		{"numeric tld", EmailAddress(), "john@example.123", 3900, nil},
+		{"label hyphen", EmailAddress(), "john@-example.com", 3900, nil},
+		{"display name", EmailAddress(), "John <john@example.com>", 3901, nil},
+		{"display name allowed", EmailAddress().AllowDisplayName(), "John <john@example.com>", 0, nil},
+		{"local part", EmailAddress(), strings.Repeat("a", 64) + "@example.com", 0, nil},
+		{"local part too long", EmailAddress(), strings.Repeat("a", 65) + "@example.com", 3902, []interface{}{"local_part", 64}},
+		{"address too long", EmailAddress(), strings.Repeat("a", 64) + "@" + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 63) + ".com", 3902, []interface{}{"address", 254}},
+		{"plus", EmailAddress(), "john+news@example.com", 0, nil},
+		{"plus not allowed", EmailAddress().DisallowPlus(), "john+news@example.com", 3903, nil},
+		{"allowed", EmailAddress().AllowDomains("example.com"), "john@example.com", 0, nil},
+		{"not allowed", EmailAddress().AllowDomains("example.com"), "john@example.org", 3904, []interface{}{"example.org"}},
+		{"allowed subdomain", EmailAddress().AllowDomains("*.example.com"), "john@mail.example.com", 0, nil},
+		{"parent of the allowed subdomains", EmailAddress().AllowDomains("*.example.com"), "john@example.com", 3904, []interface{}{"example.com"}},
+		{"allowed upper case", EmailAddress().AllowDomains("Example.COM."), "john@example.com", 0, nil},
+		{"allowed idn", EmailAddress().AllowDomains("пример.рф"), "john@пример.рф", 0, nil},
+		{"allowed idn in punycode", EmailAddress().AllowDomains("xn--e1afmkfd.xn--p1ai"), "john@ПРИМЕР.рф", 0, nil},
+		{"denied", EmailAddress().DenyDomains("example.com"), "john@example.com", 3904, []interface{}{"example.com"}},
+		{"denied idn", EmailAddress().DenyDomains("*.Пример.рф"), "john@почта.пример.рф", 3904, []interface{}{"xn--80a1acny.xn--e1afmkfd.xn--p1ai"}},
+		{"disposable", EmailAddress().DenyDisposable(), "john@mailinator.com", 3905, []interface{}{"mailinator.com"}},
+		{"disposable subdomain", EmailAddress().DenyDisposable(), "john@x.yopmail.com", 3905, []interface{}{"x.yopmail.com"}},
+		{"mx", EmailAddress().Resolver(resolver, 0), "john@example.com", 0, nil},
+		{"implicit mx", EmailAddress().Resolver(resolver, 0), "john@a-only.com", 0, nil},
+		{"null mx", EmailAddress().Resolver(resolver, 0), "john@null.com", 3906, []interface{}{"null.com"}},
+		{"no such domain", EmailAddress().Resolver(resolver, 0), "john@example.org", 3906, []interface{}{"example.org"}},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
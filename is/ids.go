@@ -0,0 +1,254 @@
+package is
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+const (
+	// DefaultNanoIDAlphabet is the URL-friendly alphabet used by NanoID by default.
+	DefaultNanoIDAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	// DefaultNanoIDSize is the default length of a NanoID.
+	DefaultNanoIDSize = 21
+
+	// crockford is the Crockford's base32 alphabet used by ULID.
+	crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// ksuidEpoch is the Unix time KSUID timestamps are counted from.
+	ksuidEpoch = 1400000000
+)
+
+var (
+	// TwitterEpoch is the epoch of the Twitter snowflake IDs.
+	TwitterEpoch = time.Date(2010, time.November, 4, 1, 42, 54, 657000000, time.UTC)
+	// DiscordEpoch is the epoch of the Discord snowflake IDs.
+	DiscordEpoch = time.Date(2015, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+var (
+	// UUIDv1 validates if a string is a valid version 1 UUID
+	UUIDv1 = validation.NewStringRule(isUUIDOf('1'), 1905)
+	// UUIDv6 validates if a string is a valid version 6 UUID
+	UUIDv6 = validation.NewStringRule(isUUIDOf('6'), 1906)
+	// UUIDv7 validates if a string is a valid version 7 UUID
+	UUIDv7 = validation.NewStringRule(isUUIDOf('7'), 1907)
+	// UUIDv8 validates if a string is a valid version 8 UUID
+	UUIDv8 = validation.NewStringRule(isUUIDOf('8'), 1908)
+	// KSUID validates if a string is a valid 27-character base62 KSUID
+	KSUID = validation.NewStringRule(isKSUID, 1912)
+)
+
+var (
+	// reUUIDVariant matches a UUID of the RFC 4122 variant, the version being checked separately.
+	reUUIDVariant = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[89abAB][0-9a-fA-F]{3}-[0-9a-fA-F]{12}$`)
+	reULID        = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+	reKSUID       = regexp.MustCompile(`^[0-9A-Za-z]{27}$`)
+	reSnowflake   = regexp.MustCompile(`^[0-9]{1,20}$`)
+
+	// maxKSUID is the largest value a 160-bit KSUID can have.
+	maxKSUID = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+)
+
+func isUUIDOf(version byte) func(string) bool {
+	return func(value string) bool {
+		return reUUIDVariant.MatchString(value) && value[14] == version
+	}
+}
+
+// ULIDTime returns the time encoded in the first ten characters of a ULID.
+// It returns false if the value is not a ULID.
+func ULIDTime(value string) (time.Time, bool) {
+	if !reULID.MatchString(value) {
+		return time.Time{}, false
+	}
+	var ms int64
+	for _, c := range strings.ToUpper(value[:10]) {
+		ms = ms<<5 | int64(strings.IndexRune(crockford, c))
+	}
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond)).UTC(), true
+}
+
+// ULID returns a validation rule that checks if a string is a ULID in the Crockford's base32 encoding.
+// By calling Min() and/or Max(), you can let the rule check that the time encoded in the ULID is
+// within the specified range.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ULID() *ULIDRule {
+	return &ULIDRule{code: 1910, rangeCode: 1911}
+}
+
+// ULIDRule is a validation rule that checks a ULID.
+type ULIDRule struct {
+	min, max  time.Time
+	code      int
+	rangeCode int
+}
+
+// Min sets the earliest time of the ULID. A zero value means skipping the check.
+func (r *ULIDRule) Min(min time.Time) *ULIDRule {
+	r.min = min
+
+	return r
+}
+
+// Max sets the latest time of the ULID. A zero value means skipping the check.
+func (r *ULIDRule) Max(max time.Time) *ULIDRule {
+	r.max = max
+
+	return r
+}
+
+// Validate checks if the given value is a valid ULID.
+func (r *ULIDRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	str, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	t, ok := ULIDTime(str)
+	if !ok {
+		code = r.code
+		return
+	}
+	if !r.min.IsZero() && t.Before(r.min) || !r.max.IsZero() && t.After(r.max) {
+		code = r.rangeCode
+	}
+	return
+}
+
+// KSUIDTime returns the time encoded in the first four bytes of a KSUID.
+// It returns false if the value is not a KSUID.
+func KSUIDTime(value string) (time.Time, bool) {
+	if !reKSUID.MatchString(value) {
+		return time.Time{}, false
+	}
+	n := new(big.Int)
+	for i := 0; i < len(value); i++ {
+		n.Mul(n, big.NewInt(62))
+		n.Add(n, big.NewInt(int64(base62Value(value[i]))))
+	}
+	if n.Cmp(maxKSUID) > 0 {
+		return time.Time{}, false
+	}
+	seconds := new(big.Int).Rsh(n, 128).Int64()
+	return time.Unix(ksuidEpoch+seconds, 0).UTC(), true
+}
+
+func isKSUID(value string) bool {
+	_, ok := KSUIDTime(value)
+	return ok
+}
+
+// base62Value returns the value of a character in the KSUID alphabet: digits, upper and then lower case letters.
+func base62Value(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 10
+	}
+	return int(c-'a') + 36
+}
+
+// NanoID returns a validation rule that checks if a string is a NanoID of the given size consisting
+// of the characters of the alphabet. DefaultNanoIDAlphabet and DefaultNanoIDSize are used for an empty
+// alphabet and a zero size.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func NanoID(alphabet string, size int) *validation.StringRule {
+	if alphabet == "" {
+		alphabet = DefaultNanoIDAlphabet
+	}
+	if size == 0 {
+		size = DefaultNanoIDSize
+	}
+
+	return validation.NewStringRule(func(value string) bool {
+		if utf8.RuneCountInString(value) != size {
+			return false
+		}
+		for _, c := range value {
+			if !strings.ContainsRune(alphabet, c) {
+				return false
+			}
+		}
+		return true
+	}, 1913)
+}
+
+// Snowflake returns a validation rule that checks if a value is a snowflake ID whose timestamp,
+// the upper 42 bits counting milliseconds since the epoch, is not in the future. The value may be
+// a decimal string or an integer.
+// By calling MaxAge(), you can let the rule check that the ID was not generated too long ago.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Snowflake(epoch time.Time) *SnowflakeRule {
+	return &SnowflakeRule{epoch: epoch, now: time.Now, code: 1914, rangeCode: 1915}
+}
+
+// SnowflakeRule is a validation rule that checks a snowflake ID.
+type SnowflakeRule struct {
+	epoch     time.Time
+	maxAge    time.Duration
+	now       func() time.Time
+	code      int
+	rangeCode int
+}
+
+// MaxAge sets the maximum age of the ID. A zero value means skipping the check.
+func (r *SnowflakeRule) MaxAge(maxAge time.Duration) *SnowflakeRule {
+	r.maxAge = maxAge
+
+	return r
+}
+
+// Clock sets the function that returns the current time, it is time.Now by default.
+func (r *SnowflakeRule) Clock(now func() time.Time) *SnowflakeRule {
+	r.now = now
+
+	return r
+}
+
+// Validate checks if the given value is a valid snowflake ID.
+func (r *SnowflakeRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	id, ok := snowflakeValue(value)
+	if !ok {
+		code = r.code
+		return
+	}
+
+	t := r.epoch.Add(time.Duration(id>>22) * time.Millisecond)
+	now := r.now()
+	if t.After(now) || r.maxAge > 0 && now.Sub(t) > r.maxAge {
+		code = r.rangeCode
+	}
+	return
+}
+
+func snowflakeValue(value interface{}) (uint64, bool) {
+	if v, err := validation.ToInt(value); err == nil {
+		return uint64(v), v > 0
+	}
+	if v, err := validation.ToUint(value); err == nil {
+		return v, true
+	}
+
+	str, code := validation.EnsureString(value)
+	if code != 0 || !reSnowflake.MatchString(str) {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(str, 10, 64)
+	return v, err == nil
+}
@@ -0,0 +1,147 @@
+package is
+
+import (
+	"testing"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestUUIDVersions(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value string
+		code  int
+	}{
+		{"v1 empty", UUIDv1, "", 0},
+		{"v1", UUIDv1, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", 0},
+		{"v1 upper case", UUIDv1, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", 0},
+		{"v1 version off", UUIDv1, "6ba7b810-9dad-21d1-80b4-00c04fd430c8", 1905},
+		{"v1 variant off", UUIDv1, "6ba7b810-9dad-11d1-c0b4-00c04fd430c8", 1905},
+		{"v6", UUIDv6, "1ec9414c-232a-6b00-b3c8-9e6bdeced846", 0},
+		{"v6 version off", UUIDv6, "1ec9414c-232a-7b00-b3c8-9e6bdeced846", 1906},
+		{"v7", UUIDv7, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", 0},
+		{"v7 without hyphens", UUIDv7, "017f22e279b07cc398c4dc0c0c07398f", 1907},
+		{"v8", UUIDv8, "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0", 0},
+		{"v8 version off", UUIDv8, "2489e9ad-2ee2-9e00-8ec9-32d5f69181c0", 1908},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestULID(t *testing.T) {
+	created := time.Date(2016, 7, 30, 23, 54, 10, 259000000, time.UTC)
+	if got, ok := ULIDTime("01ARZ3NDEKTSV4RRFFQ69G5FAV"); !ok || !got.Equal(created) {
+		t.Errorf("ULIDTime: got %v %v, want %v", got, ok, created)
+	}
+
+	tests := []struct {
+		tag   string
+		rule  *ULIDRule
+		value string
+		code  int
+	}{
+		{"empty", ULID(), "", 0},
+		{"ulid", ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FAV", 0},
+		{"lower case", ULID(), "01arz3ndektsv4rrffq69g5fav", 0},
+		{"letter U", ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FAU", 1910},
+		{"overflow", ULID(), "81ARZ3NDEKTSV4RRFFQ69G5FAV", 1910},
+		{"25 characters", ULID(), "01ARZ3NDEKTSV4RRFFQ69G5FA", 1910},
+		{"after min", ULID().Min(created), "01ARZ3NDEKTSV4RRFFQ69G5FAV", 0},
+		{"before min", ULID().Min(created.Add(time.Millisecond)), "01ARZ3NDEKTSV4RRFFQ69G5FAV", 1911},
+		{"after max", ULID().Max(created.Add(-time.Millisecond)), "01ARZ3NDEKTSV4RRFFQ69G5FAV", 1911},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestKSUID(t *testing.T) {
+	created := time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC)
+	if got, ok := KSUIDTime("0ujtsYcgvSTl8PAuAdqWYSMnLOv"); !ok || !got.Equal(created) {
+		t.Errorf("KSUIDTime: got %v %v, want %v", got, ok, created)
+	}
+
+	tests := []struct {
+		value string
+		code  int
+	}{
+		{"", 0},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", 0},
+		{"000000000000000000000000000", 0},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80V", 0},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80W", 1912},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO", 1912},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO-", 1912},
+	}
+
+	for _, test := range tests {
+		if code, _ := KSUID.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
+
+func TestNanoID(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value string
+		code  int
+	}{
+		{"empty", NanoID("", 0), "", 0},
+		{"default", NanoID("", 0), "V1StGXR8_Z5jdHi6B-myT", 0},
+		{"default too short", NanoID("", 0), "V1StGXR8_Z5jdHi6B-my", 1913},
+		{"default invalid character", NanoID("", 0), "V1StGXR8_Z5jdHi6B-my=", 1913},
+		{"custom", NanoID("0123456789abcdef", 10), "4f90d13a42", 0},
+		{"custom alphabet", NanoID("0123456789abcdef", 10), "4f90d13a4g", 1913},
+		{"multibyte alphabet", NanoID("абвгдеёжз", 6), "ёжзабв", 0},
+		{"multibyte too long", NanoID("абвгдеёжз", 6), "ёжзабвг", 1913},
+		{"multibyte too short", NanoID("абвгдеёжз", 12), "ёжзабв", 1913},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestSnowflake(t *testing.T) {
+	// 175928847299117063 is the example ID of the Discord documentation, created at 2016-04-30 11:18:25.796 UTC.
+	now := func() time.Time { return time.Date(2016, 5, 1, 0, 0, 0, 0, time.UTC) }
+	rule := func() *SnowflakeRule { return Snowflake(DiscordEpoch).Clock(now) }
+
+	tests := []struct {
+		tag   string
+		rule  *SnowflakeRule
+		value interface{}
+		code  int
+	}{
+		{"empty", rule(), "", 0},
+		{"string", rule(), "175928847299117063", 0},
+		{"int", rule(), int64(175928847299117063), 0},
+		{"uint", rule(), uint64(175928847299117063), 0},
+		{"young enough", rule().MaxAge(24 * time.Hour), "175928847299117063", 0},
+		{"too old", rule().MaxAge(time.Hour), "175928847299117063", 1915},
+		{"in the future", Snowflake(DiscordEpoch).Clock(func() time.Time { return time.Date(2016, 4, 30, 11, 18, 25, 0, time.UTC) }),
+			"175928847299117063", 1915},
+		{"negative", rule(), -1, 1914},
+		{"not a number", rule(), "17592884729911706a", 1914},
+		{"too long", rule(), "175928847299117063175", 1914},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package is
+
+import (
+	"net"
+	"regexp"
+)
+
+var (
+	// nonPublicNetworks are the address blocks that are not reachable on the public internet:
+	// "this" network, private, shared, loopback, link-local, benchmarking, documentation,
+	// multicast and reserved ones. The deprecated IPv4-compatible IPv6 addresses (::/96) are
+	// rejected as a whole since some systems still route "::127.0.0.1" to the IPv4 loopback.
+	nonPublicNetworks = mustParseCIDRs([]string{
+		"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+		"192.0.0.0/24", "192.0.2.0/24", "192.168.0.0/16", "198.18.0.0/15", "198.51.100.0/24",
+		"203.0.113.0/24", "224.0.0.0/4", "240.0.0.0/4",
+		"::/96", "64:ff9b::/96", "100::/64", "2001:db8::/32", "fc00::/7", "fe80::/10", "ff00::/8",
+	})
+
+	// reNumericHost matches the decimal, octal and hexadecimal notations of an IPv4 address
+	// that many clients accept but net.ParseIP does not, e.g. "2130706433" or "0x7f.1".
+	reNumericHost = regexp.MustCompile(`(?i)^(0x[0-9a-f]*|[0-9]+)(\.(0x[0-9a-f]*|[0-9]+)){0,3}\.?$`)
+
+	// sixToFourNetwork and teredoNetwork are the IPv6 tunnels that embed an IPv4 address.
+	sixToFourNetwork = mustParseCIDRs([]string{"2002::/16"})[0]
+	teredoNetwork    = mustParseCIDRs([]string{"2001::/32"})[0]
+)
+
+// isPublicIP checks that the IP address does not belong to a private, loopback, link-local or another
+// special-purpose block. IPv4-mapped IPv6 addresses are checked as IPv4 ones, and so are the IPv4
+// addresses embedded in the 6to4 and Teredo ones, which a tunnel would deliver the traffic to.
+func isPublicIP(ip net.IP) bool {
+	if inNetworks(nonPublicNetworks, ip) {
+		return false
+	}
+	for _, v4 := range embeddedIPv4(ip) {
+		if inNetworks(nonPublicNetworks, v4) {
+			return false
+		}
+	}
+	return true
+}
+
+// embeddedIPv4 returns the IPv4 addresses embedded in a 6to4 address (2002:AABB:CCDD::/48) or in a Teredo one,
+// which holds the address of the server in bits 32-63 and the inverted address of the client in the last 32 bits.
+func embeddedIPv4(ip net.IP) []net.IP {
+	if ip.To4() != nil {
+		return nil
+	}
+	ip = ip.To16()
+	switch {
+	case ip == nil:
+		return nil
+	case sixToFourNetwork.Contains(ip):
+		return []net.IP{ip[2:6]}
+	case teredoNetwork.Contains(ip):
+		client := make(net.IP, net.IPv4len)
+		for i := range client {
+			client[i] = ip[12+i] ^ 0xff
+		}
+		return []net.IP{ip[4:8], client}
+	}
+	return nil
+}
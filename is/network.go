@@ -0,0 +1,270 @@
+package is
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+var (
+	// privateNetworks are the private address blocks of RFC 1918 and RFC 4193.
+	privateNetworks = mustParseCIDRs([]string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"})
+
+	// PrivateIP validates if a value is an IP address from a private block (10.0.0.0/8, 172.16.0.0/12,
+	// 192.168.0.0/16 or fc00::/7)
+	PrivateIP = &ipClassRule{match: isPrivateIP, code: 3805}
+	// PublicIP validates if a value is an IP address that is not private, loopback, link-local, multicast
+	// or from another special-purpose block
+	PublicIP = &ipClassRule{match: isPublicIP, code: 3806}
+	// PortRange validates if a string is a port range such as "8000-8080", the first port not being greater
+	// than the last one. A single port is not a range, use PortNumber to validate it
+	PortRange = validation.NewStringRule(isPortRange, 3807)
+	// PortNumber validates if an integer or a string of decimal digits is a port number from 1 to 65535
+	PortNumber = &portNumberRule{code: 3808}
+	// IPRange validates if a string is a range of IP addresses of the same version such as "10.0.0.1-10.0.0.20",
+	// the first address not being greater than the last one
+	IPRange = validation.NewStringRule(isIPRange, 3809)
+
+	// rePortRange matches a range of two ports written with decimal digits.
+	rePortRange = regexp.MustCompile(`^([0-9]{1,5})-([0-9]{1,5})$`)
+	// rePort matches a port written with decimal digits.
+	rePort = regexp.MustCompile(`^[0-9]{1,5}$`)
+)
+
+// ipOf converts a string, a net.IP, a net.IPAddr or a netip.Addr into an IP address.
+// It returns false if the value is neither of them or is not a valid address.
+func ipOf(value interface{}) (net.IP, bool) {
+	switch v := value.(type) {
+	case net.IP:
+		return v, len(v) == net.IPv4len || len(v) == net.IPv6len
+	case net.IPAddr:
+		return v.IP, v.IP != nil
+	}
+	// The zero netip.Addr is considered empty and converted into nil.
+	if ip, ok := netipAddr(value); ok {
+		return ip, true
+	}
+
+	str, code := validation.EnsureString(value)
+	if code != 0 {
+		return nil, false
+	}
+	ip := net.ParseIP(str)
+	return ip, ip != nil
+}
+
+// prefixOf converts a string in the CIDR notation, a net.IPNet or a netip.Prefix into a network.
+// It returns false if the value is neither of them or is not a valid network.
+func prefixOf(value interface{}) (*net.IPNet, bool) {
+	if v, ok := value.(net.IPNet); ok {
+		return &v, v.IP != nil && v.Mask != nil
+	}
+	// The zero netip.Prefix is considered empty and converted into nil.
+	if network, ok := netipPrefix(value); ok {
+		return network, true
+	}
+
+	str, code := validation.EnsureString(value)
+	if code != 0 {
+		return nil, false
+	}
+	_, network, err := net.ParseCIDR(str)
+	return network, err == nil
+}
+
+// CIDR returns a validation rule that checks if a value is a network in the CIDR notation,
+// e.g. "192.168.0.0/16" or "2001:db8::/32", a net.IPNet or a netip.Prefix.
+// By calling PrefixLength(), you can let the rule check the length of the network prefix.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func CIDR() *CIDRRule {
+	return &CIDRRule{code: 3801, rangeCode: 3802}
+}
+
+// CIDRRule is a validation rule that checks a network in the CIDR notation.
+type CIDRRule struct {
+	min, max  int
+	code      int
+	rangeCode int
+}
+
+// PrefixLength sets the range of the prefix length. A zero max means skipping the check.
+func (r *CIDRRule) PrefixLength(min, max int) *CIDRRule {
+	r.min, r.max = min, max
+
+	return r
+}
+
+// Validate checks if the given value is a valid network.
+func (r *CIDRRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	network, ok := prefixOf(value)
+	if !ok {
+		code = r.code
+		return
+	}
+	if network == nil || r.max == 0 {
+		return
+	}
+	if ones, _ := network.Mask.Size(); ones < r.min || ones > r.max {
+		return r.rangeCode, []interface{}{r.min, r.max}
+	}
+	return
+}
+
+// InSubnet returns a validation rule that checks if a value is an IP address from one of the networks
+// given in the CIDR notation. It panics if a network is not valid.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func InSubnet(cidrs ...string) *SubnetRule {
+	return &SubnetRule{networks: mustParseCIDRs(cidrs), cidrs: cidrs, code: 3803}
+}
+
+// NotInSubnet returns a validation rule that checks if a value is an IP address from none of the networks
+// given in the CIDR notation. It panics if a network is not valid.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func NotInSubnet(cidrs ...string) *SubnetRule {
+	return &SubnetRule{networks: mustParseCIDRs(cidrs), cidrs: cidrs, exclude: true, code: 3804}
+}
+
+// SubnetRule is a validation rule that checks if an IP address belongs to networks.
+type SubnetRule struct {
+	networks []*net.IPNet
+	cidrs    []string
+	exclude  bool
+	code     int
+}
+
+// Validate checks if the given value is an IP address allowed by the rule.
+func (r *SubnetRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	ip, ok := ipOf(value)
+	if !ok {
+		code = 3800
+		return
+	}
+	if ip == nil {
+		return
+	}
+
+	if inNetworks(r.networks, ip) == r.exclude {
+		return r.code, []interface{}{strings.Join(r.cidrs, ", ")}
+	}
+	return
+}
+
+type ipClassRule struct {
+	match func(net.IP) bool
+	code  int
+}
+
+func (r *ipClassRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	ip, ok := ipOf(value)
+	if !ok {
+		code = 3800
+		return
+	}
+	if ip != nil && !r.match(ip) {
+		code = r.code
+	}
+	return
+}
+
+type portNumberRule struct {
+	code int
+}
+
+func (r *portNumberRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	if port, ok := portOf(value); !ok || port < 1 || port > 65535 {
+		code = r.code
+	}
+	return
+}
+
+// portOf converts an integer or a decimal string into a port number.
+func portOf(value interface{}) (int64, bool) {
+	if v, err := validation.ToInt(value); err == nil {
+		return v, true
+	}
+	if v, err := validation.ToUint(value); err == nil {
+		return int64(v), v <= 65535
+	}
+
+	str, code := validation.EnsureString(value)
+	if code != 0 || !rePort.MatchString(str) {
+		return 0, false
+	}
+	port, err := strconv.ParseInt(str, 10, 32)
+	return port, err == nil
+}
+
+func isPrivateIP(ip net.IP) bool {
+	return inNetworks(privateNetworks, ip)
+}
+
+func inNetworks(networks []*net.IPNet, ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPortRange(value string) bool {
+	m := rePortRange.FindStringSubmatch(value)
+	if m == nil {
+		return false
+	}
+	low, _ := strconv.Atoi(m[1])
+	high, _ := strconv.Atoi(m[2])
+	return low >= 1 && high >= low && high <= 65535
+}
+
+func isIPRange(value string) bool {
+	i := strings.IndexByte(value, '-')
+	if i < 0 {
+		return false
+	}
+	first, last := net.ParseIP(value[:i]), net.ParseIP(value[i+1:])
+	if first == nil || last == nil || (first.To4() == nil) != (last.To4() == nil) {
+		return false
+	}
+	return bytes.Compare(first.To16(), last.To16()) <= 0
+}
+
+func mustParseCIDRs(cidrs []string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("is: invalid network %q: %v", cidr, err))
+		}
+		networks[i] = network
+	}
+	return networks
+}
@@ -0,0 +1,31 @@
+//go:build go1.18
+// +build go1.18
+
+package is
+
+import (
+	"net"
+	"net/netip"
+)
+
+// netipAddr converts a netip.Addr into a net.IP, nil for the zero value.
+// It returns false if the value is not a netip.Addr.
+func netipAddr(value interface{}) (net.IP, bool) {
+	addr, ok := value.(netip.Addr)
+	if !ok || !addr.IsValid() {
+		return nil, ok
+	}
+	return net.IP(addr.Unmap().AsSlice()), true
+}
+
+// netipPrefix converts a netip.Prefix into a net.IPNet, nil for the zero value.
+// It returns false if the value is not a netip.Prefix.
+func netipPrefix(value interface{}) (*net.IPNet, bool) {
+	prefix, ok := value.(netip.Prefix)
+	if !ok || !prefix.IsValid() {
+		return nil, ok
+	}
+	prefix = prefix.Masked()
+	addr := prefix.Addr()
+	return &net.IPNet{IP: addr.AsSlice(), Mask: net.CIDRMask(prefix.Bits(), addr.BitLen())}, true
+}
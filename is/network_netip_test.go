@@ -0,0 +1,35 @@
+//go:build go1.18
+// +build go1.18
+
+package is
+
+import (
+	"net/netip"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestNetip(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"zero addr", PublicIP, netip.Addr{}, 0},
+		{"public addr", PublicIP, netip.MustParseAddr("8.8.8.8"), 0},
+		{"mapped addr", PublicIP, netip.MustParseAddr("::ffff:127.0.0.1"), 3806},
+		{"addr in subnet", InSubnet("10.0.0.0/8"), netip.MustParseAddr("10.0.0.1"), 0},
+		{"addr not in subnet", InSubnet("10.0.0.0/8"), netip.MustParseAddr("11.0.0.1"), 3803},
+		{"zero prefix", CIDR(), netip.Prefix{}, 0},
+		{"prefix", CIDR().PrefixLength(8, 16), netip.MustParsePrefix("10.1.0.0/16"), 0},
+		{"prefix too long", CIDR().PrefixLength(8, 16), netip.MustParsePrefix("10.1.1.0/24"), 3802},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
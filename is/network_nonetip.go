@@ -0,0 +1,16 @@
+//go:build !go1.18
+// +build !go1.18
+
+package is
+
+import "net"
+
+// netipAddr always returns false, net/netip is available since Go 1.18.
+func netipAddr(interface{}) (net.IP, bool) {
+	return nil, false
+}
+
+// netipPrefix always returns false, net/netip is available since Go 1.18.
+func netipPrefix(interface{}) (*net.IPNet, bool) {
+	return nil, false
+}
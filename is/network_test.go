@@ -0,0 +1,165 @@
+package is
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestCIDR(t *testing.T) {
+	_, network, _ := net.ParseCIDR("10.0.0.0/8")
+
+	tests := []struct {
+		tag   string
+		rule  *CIDRRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", CIDR(), "", 0, nil},
+		{"v4", CIDR(), "192.168.0.0/16", 0, nil},
+		{"v6", CIDR(), "2001:db8::/32", 0, nil},
+		{"host bits", CIDR(), "192.168.1.1/16", 0, nil},
+		{"ipnet", CIDR(), *network, 0, nil},
+		{"no prefix", CIDR(), "192.168.0.0", 3801, nil},
+		{"prefix too long", CIDR(), "192.168.0.0/33", 3801, nil},
+		{"address", CIDR(), "192.168.0.300/16", 3801, nil},
+		{"prefix length", CIDR().PrefixLength(16, 24), "192.168.0.0/24", 0, nil},
+		{"prefix too short", CIDR().PrefixLength(16, 24), "10.0.0.0/8", 3802, []interface{}{16, 24}},
+		{"prefix too long for the range", CIDR().PrefixLength(16, 24), "192.168.0.0/25", 3802, []interface{}{16, 24}},
+		{"not a string", CIDR(), 42, 3801, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestSubnet(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *SubnetRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", InSubnet("10.0.0.0/8"), "", 0, nil},
+		{"in", InSubnet("10.0.0.0/8", "192.168.0.0/16"), "192.168.1.1", 0, nil},
+		{"not in", InSubnet("10.0.0.0/8", "192.168.0.0/16"), "11.0.0.1", 3803, []interface{}{"10.0.0.0/8, 192.168.0.0/16"}},
+		{"edge", InSubnet("10.0.0.0/8"), "10.255.255.255", 0, nil},
+		{"v6", InSubnet("2001:db8::/32"), "2001:db8::1", 0, nil},
+		{"mapped v4", InSubnet("10.0.0.0/8"), "::ffff:10.0.0.1", 0, nil},
+		{"net.IP", InSubnet("10.0.0.0/8"), net.ParseIP("10.0.0.1"), 0, nil},
+		{"net.IPAddr", InSubnet("10.0.0.0/8"), net.IPAddr{IP: net.ParseIP("10.0.0.1")}, 0, nil},
+		{"excluded", NotInSubnet("10.0.0.0/8"), "10.0.0.1", 3804, []interface{}{"10.0.0.0/8"}},
+		{"not excluded", NotInSubnet("10.0.0.0/8"), "11.0.0.1", 0, nil},
+		{"not an address", InSubnet("10.0.0.0/8"), "10.0.0", 3800, nil},
+		{"invalid net.IP", InSubnet("10.0.0.0/8"), net.IP{10, 0, 0}, 3800, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestInSubnetPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	InSubnet("10.0.0.0/33")
+}
+
+func TestIPClasses(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"private empty", PrivateIP, "", 0},
+		{"private 10", PrivateIP, "10.1.2.3", 0},
+		{"private 172.16", PrivateIP, "172.16.0.1", 0},
+		{"private 172.32", PrivateIP, "172.32.0.1", 3805},
+		{"private 192.168", PrivateIP, "192.168.0.1", 0},
+		{"private fc00", PrivateIP, "fd12:3456::1", 0},
+		{"loopback is not private", PrivateIP, "127.0.0.1", 3805},
+		{"public", PublicIP, "8.8.8.8", 0},
+		{"public v6", PublicIP, "2606:4700:4700::1111", 0},
+		{"public net.IP", PublicIP, net.ParseIP("1.1.1.1"), 0},
+		{"private is not public", PublicIP, "192.168.0.1", 3806},
+		{"shared", PublicIP, "100.64.0.1", 3806},
+		{"link-local", PublicIP, "169.254.169.254", 3806},
+		{"benchmarking", PublicIP, "198.18.0.1", 3806},
+		{"documentation", PublicIP, "203.0.113.5", 3806},
+		{"multicast", PublicIP, "224.0.0.1", 3806},
+		{"reserved", PublicIP, "240.0.0.1", 3806},
+		{"broadcast", PublicIP, "255.255.255.255", 3806},
+		{"v6 documentation", PublicIP, "2001:db8::1", 3806},
+		{"v6 link-local", PublicIP, "fe80::1", 3806},
+		{"v6 multicast", PublicIP, "ff02::1", 3806},
+		{"not an address", PublicIP, "8.8.8", 3800},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestPortsAndRanges(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value interface{}
+		code  int
+	}{
+		{"port empty", PortNumber, "", 0},
+		{"port", PortNumber, 443, 0},
+		{"port string", PortNumber, "8080", 0},
+		{"port uint16", PortNumber, uint16(65535), 0},
+		{"port too large", PortNumber, 65536, 3808},
+		{"port uint too large", PortNumber, uint64(1 << 40), 3808},
+		{"port negative", PortNumber, -1, 3808},
+		{"port not a number", PortNumber, "http", 3808},
+		{"port with a sign", PortNumber, "+80", 3808},
+		{"port with spaces", PortNumber, " 80", 3808},
+		{"range empty", PortRange, "", 0},
+		{"range", PortRange, "8000-8080", 0},
+		{"range with spaces", PortRange, "8000 - 8080", 3807},
+		{"range with a leading space", PortRange, " 80-90", 3807},
+		{"range with signs", PortRange, "+80-+90", 3807},
+		{"single port", PortRange, "22", 3807},
+		{"same port", PortRange, "22-22", 0},
+		{"reversed", PortRange, "8080-8000", 3807},
+		{"zero", PortRange, "0-80", 3807},
+		{"too large", PortRange, "80-65536", 3807},
+		{"not a range", PortRange, "80-", 3807},
+		{"ip range empty", IPRange, "", 0},
+		{"ip range", IPRange, "10.0.0.1-10.0.0.20", 0},
+		{"ip range of one address", IPRange, "10.0.0.1-10.0.0.1", 0},
+		{"ip range v6", IPRange, "2001:db8::1-2001:db8::ff", 0},
+		{"ip range reversed", IPRange, "10.0.0.20-10.0.0.1", 3809},
+		{"ip range of mixed versions", IPRange, "10.0.0.1-2001:db8::1", 3809},
+		{"ip range with spaces", IPRange, "10.0.0.1 - 10.0.0.20", 3809},
+		{"single ip", IPRange, "10.0.0.1", 3809},
+		{"ip range cidr", IPRange, "10.0.0.0/8", 3809},
+		{"ip range invalid address", IPRange, "10.0.0.1-10.0.0.256", 3809},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
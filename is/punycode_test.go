@@ -0,0 +1,47 @@
+package is
+
+import "testing"
+
+func TestPunycode(t *testing.T) {
+	// The sample strings of RFC 3492, section 7.1.
+	tests := []struct {
+		tag, value, encoded string
+	}{
+		{"arabic", "ليهمابتكلموشعربي؟", "egbpdaj6bu4bxfgehfvwxn"},
+		{"chinese", "他们为什么不说中文", "ihqwcrb4cv8a8dqg056pqjye"},
+		{"czech", "Pročprostěnemluvíčesky", "Proprostnemluvesky-uyb24dma41a"},
+		{"japanese", "なぜみんな日本語を話してくれないのか", "n8jok5ay5dzabd5bym9f0cm5685rrjetr6pdxa"},
+		{"russian", "почемужеонинеговорятпорусски", "b1abfaaepdrnnbgefbadotcwatmq2g4l"},
+		{"spanish", "PorquénopuedensimplementehablarenEspañol", "PorqunopuedensimplementehablarenEspaol-fmd56a"},
+		{"mixed", "3年B組金八先生", "3B-ww4c5e180e575a65lsy2b"},
+		{"hyphens", "安室奈美恵-with-SUPER-MONKEYS", "-with-SUPER-MONKEYS-pc58ag80a8qai00g7n9n"},
+		{"basic inside", "パフィーdeルンバ", "de-jg4avhby1noc0d"},
+		{"katakana", "そのスピードで", "d9juau41awczczp"},
+		{"basic only", "-> $1.00 <-", "-> $1.00 <--"},
+	}
+
+	for _, test := range tests {
+		if got := punycode(test.value); got != test.encoded {
+			t.Errorf("%s: got %v, want %v", test.tag, got, test.encoded)
+		}
+	}
+}
+
+func TestToASCIIDomain(t *testing.T) {
+	tests := []struct {
+		value, ascii string
+	}{
+		{"example.com", "example.com"},
+		{"пример.рф", "xn--e1afmkfd.xn--p1ai"},
+		{"ПРИМЕР.рф", "xn--e1afmkfd.xn--p1ai"},
+		{"bu\u0308cher.de", "xn--bcher-kva.de"},
+		{"bücher.de", "xn--bcher-kva.de"},
+		{"*.münchen.de", "*.xn--mnchen-3ya.de"},
+	}
+
+	for _, test := range tests {
+		if got := toASCIIDomain(test.value); got != test.ascii {
+			t.Errorf("%v: got %v, want %v", test.value, got, test.ascii)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package is
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+var (
+	// IMEI validates if a string is a 15-digit IMEI with a valid Luhn check digit
+	IMEI = validation.NewStringRule(isIMEI, 3501)
+	// IMEISV validates if a string is a 16-digit IMEISV, which has a software version instead of a check digit
+	IMEISV = validation.NewStringRule(isIMEISV, 3502)
+	// ICCID validates if a string is a SIM card number of 19 or 20 digits starting with 89 with a valid Luhn check digit
+	ICCID = validation.NewStringRule(isICCID, 3503)
+	// IMSI validates if a string is an IMSI with a known mobile country code
+	IMSI = validation.NewStringRule(isIMSI, 3504)
+	// MEID validates if a string is a MEID, either 14 hexadecimal or 18 decimal digits with an optional check digit
+	MEID = validation.NewStringRule(isMEID, 3505)
+	// MSISDN validates if a string is a mobile subscriber number in the international format, with or without "+"
+	MSISDN = validation.NewStringRule(isMSISDN, 3506)
+)
+
+var (
+	reIMEI       = regexp.MustCompile(`^[0-9]{15}$`)
+	reIMEISV     = regexp.MustCompile(`^[0-9]{14}([0-8][0-9]|9[0-8])$`)
+	reICCID      = regexp.MustCompile(`^89[0-9]{17,18}$`)
+	reIMSI       = regexp.MustCompile(`^[0-9]{14,15}$`)
+	reMEIDHex    = regexp.MustCompile(`^[0-9A-F]{14}[0-9A-F]?$`)
+	reMEIDDec    = regexp.MustCompile(`^[0-9]{18}[0-9]?$`)
+	reMSISDN     = regexp.MustCompile(`^\+?[1-9][0-9]{7,14}$`)
+	imeiReplacer = strings.NewReplacer(" ", "", "-", "", "/", "")
+)
+
+// IMSIInfo is the information encoded in an IMSI.
+type IMSIInfo struct {
+	// MCC is the three-digit mobile country code.
+	MCC string
+	// MNC is the two or three-digit mobile network code.
+	MNC string
+	// MSIN is the mobile subscription identification number.
+	MSIN string
+	// Country is the ISO 3166 alpha-2 code of the country of the MCC, "XX" for the international networks.
+	Country string
+}
+
+// ParseIMSI splits an IMSI into its parts. It returns false if the value is not an IMSI with a known
+// mobile country code. The length of the MNC is taken from the table of the countries that use
+// three-digit codes, India (405) being considered one of them.
+func ParseIMSI(value string) (info IMSIInfo, ok bool) {
+	if !reIMSI.MatchString(value) {
+		return
+	}
+	mcc := value[:3]
+	country, ok := mobileCountryCodes[mcc]
+	if !ok {
+		return
+	}
+
+	mncLength := 2
+	if threeDigitMNC[mcc] {
+		mncLength = 3
+	}
+	info = IMSIInfo{MCC: mcc, MNC: value[3 : 3+mncLength], MSIN: value[3+mncLength:], Country: country}
+	return
+}
+
+func isIMEI(value string) bool {
+	value = imeiReplacer.Replace(value)
+	return reIMEI.MatchString(value) && checksum.Luhn(value)
+}
+
+func isIMEISV(value string) bool {
+	return reIMEISV.MatchString(imeiReplacer.Replace(value))
+}
+
+func isICCID(value string) bool {
+	value = strings.ReplaceAll(value, " ", "")
+	return reICCID.MatchString(value) && checksum.Luhn(value)
+}
+
+func isIMSI(value string) bool {
+	_, ok := ParseIMSI(value)
+	return ok
+}
+
+// isMEID checks a MEID. A hexadecimal check digit is calculated with the Luhn algorithm in base 16,
+// unless all the digits are decimal, and a decimal one in base 10.
+func isMEID(value string) bool {
+	value = strings.ToUpper(imeiReplacer.Replace(value))
+
+	switch {
+	case reMEIDHex.MatchString(value):
+		if len(value) == 14 {
+			return true
+		}
+		if isDigit(value) {
+			return checksum.Luhn(value)
+		}
+		return checksum.LuhnBase(value, 16)
+	case reMEIDDec.MatchString(value):
+		// The decimal form is the 32-bit manufacturer code and the 24-bit serial number written in decimal.
+		manufacturer, _ := strconv.ParseUint(value[:10], 10, 64)
+		serial, _ := strconv.ParseUint(value[10:18], 10, 64)
+		if manufacturer > 0xFFFFFFFF || serial > 0xFFFFFF {
+			return false
+		}
+		return len(value) == 18 || checksum.Luhn(value)
+	}
+	return false
+}
+
+func isMSISDN(value string) bool {
+	return reMSISDN.MatchString(value)
+}
@@ -0,0 +1,78 @@
+package is
+
+import (
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestTelecom(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value string
+		code  int
+	}{
+		{"IMEI empty", IMEI, "", 0},
+		{"IMEI", IMEI, "490154203237518", 0},
+		{"IMEI formatted", IMEI, "49-015420-323751-8", 0},
+		{"IMEI check digit off", IMEI, "490154203237519", 3501},
+		{"IMEI digit off", IMEI, "490154203247518", 3501},
+		{"IMEI 14 digits", IMEI, "49015420323751", 3501},
+		{"IMEISV", IMEISV, "4901542032375101", 0},
+		{"IMEISV reserved version", IMEISV, "4901542032375199", 3502},
+		{"IMEISV 15 digits", IMEISV, "490154203237510", 3502},
+		{"ICCID 20", ICCID, "89014103211118510720", 0},
+		{"ICCID 20 other", ICCID, "89701012345678901234", 0},
+		{"ICCID 19", ICCID, "8901410321111851072", 0},
+		{"ICCID 18", ICCID, "890141032111185107", 3503},
+		{"ICCID with spaces", ICCID, "8901 4103 2111 1851 0720", 0},
+		{"ICCID off", ICCID, "89014103211118510721", 3503},
+		{"ICCID prefix", ICCID, "88014103211118510720", 3503},
+		{"IMSI", IMSI, "250011234567890", 0},
+		{"IMSI three-digit MNC", IMSI, "310150123456789", 0},
+		{"IMSI unknown MCC", IMSI, "999011234567890", 3504},
+		{"IMSI short", IMSI, "2500112345678", 3504},
+		{"MEID hex", MEID, "A0000000002329", 0},
+		{"MEID hex lower case", MEID, "a0000000002329", 0},
+		{"MEID hex with check digit", MEID, "A00000000023299", 0},
+		{"MEID hex check digit off", MEID, "A00000000023298", 3505},
+		{"MEID decimal digits with check digit", MEID, "351451208401216", 0},
+		{"MEID decimal digits check digit off", MEID, "351451208401217", 3505},
+		{"MEID decimal", MEID, "270113177609606898", 0},
+		{"MEID decimal with check digit", MEID, "2701131776096068984", 0},
+		{"MEID decimal check digit off", MEID, "2701131776096068985", 3505},
+		{"MEID decimal manufacturer overflow", MEID, "999999999909606898", 3505},
+		{"MEID decimal serial overflow", MEID, "270113177699999999", 3505},
+		{"MSISDN", MSISDN, "+79123456789", 0},
+		{"MSISDN without plus", MSISDN, "4915123456789", 0},
+		{"MSISDN leading zero", MSISDN, "+09123456789", 3506},
+		{"MSISDN too long", MSISDN, "+7912345678901234", 3506},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
+
+func TestParseIMSI(t *testing.T) {
+	tests := []struct {
+		value string
+		ok    bool
+		info  IMSIInfo
+	}{
+		{"250011234567890", true, IMSIInfo{MCC: "250", MNC: "01", MSIN: "1234567890", Country: "RU"}},
+		{"310150123456789", true, IMSIInfo{MCC: "310", MNC: "150", MSIN: "123456789", Country: "US"}},
+		{"999011234567890", false, IMSIInfo{}},
+		{"25001123456789A", false, IMSIInfo{}},
+	}
+
+	for _, test := range tests {
+		info, ok := ParseIMSI(test.value)
+		if ok != test.ok || info != test.info {
+			t.Errorf("%v: got %v %+v, want %v %+v", test.value, ok, info, test.ok, test.info)
+		}
+	}
+}
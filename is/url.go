@@ -0,0 +1,262 @@
+package is
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+const (
+	// DefaultURLMaxLength is the maximum length of a URL accepted by SafeURL by default.
+	DefaultURLMaxLength = 2048
+	// DefaultResolveTimeout is the time a host name resolution may take by default.
+	DefaultResolveTimeout = 5 * time.Second
+)
+
+// Resolver resolves host names into IP addresses. *net.Resolver implements it.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// defaultPorts are the ports used by the schemes when the URL has none.
+var defaultPorts = map[string]int{"http": 80, "https": 443, "ws": 80, "wss": 443, "ftp": 21}
+
+// URLRule is a validation rule that checks a URL received from an untrusted party, e.g. a webhook URL.
+type URLRule struct {
+	schemes      []string
+	allowHosts   []string
+	denyHosts    []string
+	ports        []int
+	maxLength    int
+	userinfo     bool
+	private      bool
+	resolver     Resolver
+	timeout      time.Duration
+	code         int
+	schemeCode   int
+	hostCode     int
+	userinfoCode int
+	portCode     int
+	lengthCode   int
+	addressCode  int
+	resolveCode  int
+}
+
+// SafeURL returns a validation rule that checks a URL with protection against server-side request forgery.
+// By default only the "http" and "https" schemes are allowed, the URL must not contain credentials, be longer
+// than DefaultURLMaxLength or have a literal IP address that is private, loopback, link-local or reserved.
+// Host names are not resolved unless a resolver is set by calling Resolver(); the addresses they resolve to
+// are then checked as well.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SafeURL() *URLRule {
+	return &URLRule{
+		schemes:      []string{"http", "https"},
+		maxLength:    DefaultURLMaxLength,
+		timeout:      DefaultResolveTimeout,
+		code:         3700,
+		schemeCode:   3701,
+		hostCode:     3702,
+		userinfoCode: 3703,
+		portCode:     3704,
+		lengthCode:   3705,
+		addressCode:  3706,
+		resolveCode:  3707,
+	}
+}
+
+// Schemes sets the allowed schemes.
+func (r *URLRule) Schemes(schemes ...string) *URLRule {
+	r.schemes = schemes
+
+	return r
+}
+
+// AllowHosts sets the only hosts allowed. A host starting with "*." matches any subdomain of the domain.
+func (r *URLRule) AllowHosts(hosts ...string) *URLRule {
+	r.allowHosts = hosts
+
+	return r
+}
+
+// DenyHosts sets the hosts that are not allowed. A host starting with "*." matches any subdomain of the domain.
+func (r *URLRule) DenyHosts(hosts ...string) *URLRule {
+	r.denyHosts = hosts
+
+	return r
+}
+
+// Ports sets the allowed ports. Any port is allowed if none is set.
+func (r *URLRule) Ports(ports ...int) *URLRule {
+	r.ports = ports
+
+	return r
+}
+
+// MaxLength sets the maximum length of the URL. A zero value means skipping the check.
+func (r *URLRule) MaxLength(max int) *URLRule {
+	r.maxLength = max
+
+	return r
+}
+
+// AllowUserinfo allows the URL to contain a user name and a password.
+func (r *URLRule) AllowUserinfo() *URLRule {
+	r.userinfo = true
+
+	return r
+}
+
+// AllowPrivate allows the URL to point to private, loopback and other non-public addresses.
+func (r *URLRule) AllowPrivate() *URLRule {
+	r.private = true
+
+	return r
+}
+
+// Resolver sets the resolver used to check the addresses of host names and the time the resolution may take.
+// A zero timeout means DefaultResolveTimeout.
+func (r *URLRule) Resolver(resolver Resolver, timeout time.Duration) *URLRule {
+	r.resolver = resolver
+	if r.timeout = timeout; timeout == 0 {
+		r.timeout = DefaultResolveTimeout
+	}
+
+	return r
+}
+
+// Validate checks if the given value is a URL that satisfies the rule.
+func (r *URLRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return
+	}
+
+	str, code := validation.EnsureString(value)
+	if code != 0 {
+		return
+	}
+
+	if r.maxLength > 0 && len(str) > r.maxLength {
+		return r.lengthCode, []interface{}{r.maxLength}
+	}
+
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme == "" {
+		code = r.code
+		return
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if !containsFold(r.schemes, scheme) {
+		return r.schemeCode, []interface{}{scheme}
+	}
+	if u.Host == "" || u.Opaque != "" {
+		code = r.code
+		return
+	}
+	if u.User != nil && !r.userinfo {
+		code = r.userinfoCode
+		return
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		code = r.code
+		return
+	}
+	if len(r.allowHosts) > 0 && !matchHosts(r.allowHosts, host) || matchHosts(r.denyHosts, host) {
+		return r.hostCode, []interface{}{host}
+	}
+
+	if code, args = r.validatePort(u, scheme); code != 0 {
+		return
+	}
+
+	if r.private {
+		return
+	}
+	return r.validateAddress(host)
+}
+
+func (r *URLRule) validatePort(u *url.URL, scheme string) (code int, args []interface{}) {
+	port := defaultPorts[scheme]
+	if p := u.Port(); p != "" {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 1 || n > 65535 {
+			code = r.code
+			return
+		}
+		port = n
+	}
+
+	if len(r.ports) == 0 {
+		return
+	}
+	for _, p := range r.ports {
+		if p == port {
+			return
+		}
+	}
+	return r.portCode, []interface{}{port}
+}
+
+// validateAddress checks that the host is not a non-public IP address and, if a resolver is set,
+// that it does not resolve to one.
+func (r *URLRule) validateAddress(host string) (code int, args []interface{}) {
+	if ip := net.ParseIP(strings.SplitN(host, "%", 2)[0]); ip != nil {
+		if !isPublicIP(ip) {
+			return r.addressCode, []interface{}{host}
+		}
+		return
+	}
+	// A numeric host like "2130706433" is an IPv4 address in an unusual notation.
+	if reNumericHost.MatchString(host) || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return r.addressCode, []interface{}{host}
+	}
+
+	if r.resolver == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	addrs, err := r.resolver.LookupIPAddr(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return r.resolveCode, []interface{}{host}
+	}
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return r.addressCode, []interface{}{host}
+		}
+	}
+	return
+}
+
+// matchHosts checks if the host matches one of the patterns, a pattern starting with "*." matching the subdomains.
+func matchHosts(patterns []string, host string) bool {
+	for _, p := range patterns {
+		p = strings.TrimSuffix(strings.ToLower(p), ".")
+		if strings.HasPrefix(p, "*.") {
+			if strings.HasSuffix(host, p[1:]) {
+				return true
+			}
+		} else if host == p {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,51 +1,139 @@
 package validation
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
-func MultipleOf(threshold interface{}) *multipleOfRule {
-	return &multipleOfRule{
-		threshold,
-		1106,
+// DefaultMultipleOfTolerance is the deviation from a multiple allowed by MultipleOf when either the value
+// or the threshold is a float.
+const DefaultMultipleOfTolerance = 1e-9
+
+// MultipleOf returns a validation rule that checks if a value is a multiple of the given threshold.
+// Integers, unsigned integers, json.Number, decimal strings and big.Rat are compared exactly.
+// Floats are converted from their shortest decimal form, and if either the value or the threshold is a float,
+// the value may deviate from the nearest multiple by a tolerance which can be changed by calling Tolerance.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MultipleOf(threshold interface{}) *MultipleOfRule {
+	return &MultipleOfRule{
+		threshold: threshold,
+		tolerance: DefaultMultipleOfTolerance,
+		code:      1106,
 	}
 }
 
-type multipleOfRule struct {
+type MultipleOfRule struct {
 	threshold interface{}
+	tolerance float64
 	code      int
 }
 
-func (r *multipleOfRule) Validate(value interface{}) (code int, args []interface{}) {
-	rv := reflect.ValueOf(r.threshold)
-	args = append(args, rv.Kind())
+// Tolerance sets the maximum deviation allowed when floats are compared.
+func (r *MultipleOfRule) Tolerance(tolerance float64) *MultipleOfRule {
+	r.tolerance = math.Abs(tolerance)
+
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r *MultipleOfRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return
+	}
+
+	threshold, _ := Indirect(r.threshold)
+
+	t, ok := toRat(threshold)
+	if !ok || t.Sign() == 0 {
+		code = 1006
+		return
+	}
+	v, ok := toRat(value)
+	if !ok {
+		code = 1006
+		return
+	}
+
+	tolerance := new(big.Rat)
+	if isFloatKind(value) || isFloatKind(threshold) {
+		tolerance.SetFloat64(r.tolerance)
+	}
+	if distanceToMultiple(v, t).Cmp(tolerance) > 0 {
+		code, args = r.code, []interface{}{formatThreshold(threshold)}
+	}
+
+	return
+}
+
+// distanceToMultiple returns the distance from the value to the nearest multiple of the threshold.
+func distanceToMultiple(value, threshold *big.Rat) *big.Rat {
+	q := new(big.Rat).Quo(value, threshold)
+	n := new(big.Rat).SetInt(new(big.Int).Quo(q.Num(), q.Denom()))
+	rem := new(big.Rat).Sub(value, n.Mul(n, threshold))
+	rem.Abs(rem)
+
+	other := new(big.Rat).Abs(threshold)
+	if other.Sub(other, rem).Cmp(rem) < 0 {
+		return other
+	}
+	return rem
+}
+
+func isFloatKind(value interface{}) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// toRat converts an integer, json.Number, decimal string or big.Rat into an exact rational number.
+// A float is converted from the shortest decimal form that represents it, so 0.1 becomes exactly 1/10.
+func toRat(value interface{}) (*big.Rat, bool) {
+	switch v := value.(type) {
+	case *big.Rat:
+		return new(big.Rat).Set(v), true
+	case big.Rat:
+		return new(big.Rat).Set(&v), true
+	case json.Number:
+		return new(big.Rat).SetString(string(v))
+	}
+
+	rv := reflect.ValueOf(value)
 	switch rv.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		v, err := ToInt(value)
-		if err != nil {
-			code = r.code
-			return
-		}
-		if v%rv.Int() == 0 {
-			code = r.code
-			return
-		}
-
+		return new(big.Rat).SetInt64(rv.Int()), true
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		v, err := ToUint(value)
-		if err != nil {
-			code = r.code
-			return
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(rv.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		bitSize := 64
+		if rv.Kind() == reflect.Float32 {
+			bitSize = 32
 		}
-
-		if v%rv.Uint() == 0 {
-			code = r.code
-			return
+		return new(big.Rat).SetString(strconv.FormatFloat(rv.Float(), 'g', -1, bitSize))
+	case reflect.String:
+		s := strings.TrimSpace(rv.String())
+		if strings.ContainsAny(s, "/eE") {
+			// only plain decimal notation is accepted
+			return nil, false
 		}
-	default:
-		code = r.code
-		return
+		return new(big.Rat).SetString(s)
 	}
 
-	return
+	return nil, false
+}
+
+func formatThreshold(threshold interface{}) interface{} {
+	switch t := threshold.(type) {
+	case *big.Rat:
+		return t.RatString()
+	case big.Rat:
+		return t.RatString()
+	}
+	return threshold
 }
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestMultipleOf(t *testing.T) {
+	var nilInt *int
+	three := 3
+
+	tests := []struct {
+		tag       string
+		threshold interface{}
+		value     interface{}
+		code      int
+		args      []interface{}
+	}{
+		{"empty", 3, 0, 0, nil},
+		{"nil pointer", 3, nilInt, 0, nil},
+		{"int", 3, 9, 0, nil},
+		{"int not multiple", 3, 10, 1106, []interface{}{3}},
+		{"negative int", 3, -9, 0, nil},
+		{"uint", uint(5), uint64(25), 0, nil},
+		{"int pointer threshold", &three, 12, 0, nil},
+		{"float", 0.1, 0.3, 0, nil},
+		{"float sum", 0.1, 0.1 + 0.2, 0, nil},
+		{"float not multiple", 0.1, 0.35, 1106, []interface{}{0.1}},
+		{"large float", 0.01, 123456789012.91, 0, nil},
+		{"large float not multiple", 0.01, 123456789012.915, 1106, []interface{}{0.01}},
+		{"float32", 0.1, float32(0.3), 0, nil},
+		{"float and int", 2, 4.0, 0, nil},
+		{"float and int not multiple", 2, 5.0, 1106, []interface{}{2}},
+		{"json.Number", json.Number("0.5"), json.Number("1.5"), 0, nil},
+		{"decimal string", "0.25", "1.75", 0, nil},
+		{"decimal string not multiple", "0.25", "1.8", 1106, []interface{}{"0.25"}},
+		{"exponent string", 1, "1e3", 1006, nil},
+		{"big.Rat", big.NewRat(1, 3), big.NewRat(2, 3), 0, nil},
+		{"big.Rat not multiple", big.NewRat(1, 3), big.NewRat(1, 2), 1106, []interface{}{"1/3"}},
+		{"zero threshold", 0, 5, 1006, nil},
+		{"not a number", 3, "abc", 1006, nil},
+		{"NaN", 0.1, math.NaN(), 1006, nil},
+	}
+
+	for _, test := range tests {
+		code, args := MultipleOf(test.threshold).Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestMultipleOfTolerance(t *testing.T) {
+	r := MultipleOf(1.0).Tolerance(-0.01)
+	if code, _ := r.Validate(1.005); code != 0 {
+		t.Errorf("1.005 within the tolerance: got %v", code)
+	}
+	if code, _ := r.Validate(1.05); code != 1106 {
+		t.Errorf("1.05 outside the tolerance: got %v", code)
+	}
+	// integers are compared exactly whatever the tolerance
+	if code, _ := MultipleOf(3).Tolerance(1).Validate(10); code != 1106 {
+		t.Errorf("10 is not a multiple of 3: got %v", code)
+	}
+}
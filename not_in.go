@@ -1,18 +1,77 @@
 package validation
 
+import (
+	"io"
+	"time"
+)
+
 // NotIn returns a validation rule that checks if a value os absent from, the given list of values.
-// Note that the value being checked and the possible range of values must be of the same type.
+// Note that the value being checked and the possible range of values must be of the same type,
+// unless the comparison is relaxed by calling Numeric, FoldCase or Normalize.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func NotIn(values ...interface{}) *NotInRule {
 	return &NotInRule{
-		elements: values,
-		code:     1107,
+		set:  newValueSet(values),
+		code: 1107,
+	}
+}
+
+// NotInFromReader returns a NotIn rule with the values read from the reader, one value per line.
+// Empty lines and lines starting with # are ignored.
+func NotInFromReader(r io.Reader) (*NotInRule, error) {
+	values, err := readValues(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return NotIn(values...), nil
+}
+
+// NotInFromFile returns a NotIn rule with the values read from the file, one value per line.
+// Empty lines and lines starting with # are ignored.
+func NotInFromFile(path string) (*NotInRule, error) {
+	values, err := readValuesFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NotIn(values...), nil
+}
+
+// NotInFromProvider returns a NotIn rule with the values returned by the provider.
+// The values are requested again when they are older than refresh. A zero refresh means the values are loaded once.
+func NotInFromProvider(provider func() []interface{}, refresh time.Duration) *NotInRule {
+	return &NotInRule{
+		set:  newProvidedValueSet(provider, refresh),
+		code: 1107,
 	}
 }
 
 type NotInRule struct {
-	elements []interface{}
-	code     int
+	set  *valueSet
+	code int
+}
+
+// Numeric makes the rule compare numbers by value regardless of their type.
+// Strings holding decimal numbers, such as the values read from a file, are compared as numbers too.
+func (r *NotInRule) Numeric() *NotInRule {
+	r.set.configure(func(s *valueSet) { s.numeric = true })
+
+	return r
+}
+
+// FoldCase makes the rule compare strings case-insensitively.
+func (r *NotInRule) FoldCase() *NotInRule {
+	r.set.configure(func(s *valueSet) { s.foldCase = true })
+
+	return r
+}
+
+// Normalize makes the rule compare strings in the Unicode normalization form C.
+func (r *NotInRule) Normalize() *NotInRule {
+	r.set.configure(func(s *valueSet) { s.normalize = true })
+
+	return r
 }
 
 // Validate checks if the given value is valid or not.
@@ -21,11 +80,9 @@ func (r *NotInRule) Validate(value interface{}) (code int, args []interface{}) {
 	if isNil || IsEmpty(value) {
 		return
 	}
-	for _, e := range r.elements {
-		if e == value {
-			code = r.code
-			return
-		}
+
+	if r.set.contains(value) {
+		code = r.code
 	}
 	return
 }
@@ -0,0 +1,251 @@
+package validation
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// valueSet is a list of values backed by a hash set, used by the In and NotIn rules.
+// Values that cannot be used as map keys are kept aside and compared with reflect.DeepEqual.
+type valueSet struct {
+	mu        sync.RWMutex
+	elements  []interface{}
+	keys      map[interface{}]struct{}
+	rest      []interface{}
+	numeric   bool
+	foldCase  bool
+	normalize bool
+
+	provider func() []interface{}
+	refresh  time.Duration
+	loadedAt time.Time
+}
+
+func newValueSet(values []interface{}) *valueSet {
+	s := &valueSet{}
+	s.reset(values)
+
+	return s
+}
+
+func newProvidedValueSet(provider func() []interface{}, refresh time.Duration) *valueSet {
+	s := &valueSet{provider: provider, refresh: refresh}
+	s.reset(provider())
+	s.loadedAt = time.Now()
+
+	return s
+}
+
+// reset replaces the elements of the set and rebuilds the lookup table.
+func (s *valueSet) reset(values []interface{}) {
+	s.elements = values
+	s.rebuild()
+}
+
+func (s *valueSet) rebuild() {
+	keys := make(map[interface{}]struct{}, len(s.elements))
+	rest := make([]interface{}, 0)
+	for _, e := range s.elements {
+		k := s.key(e)
+		if !isHashable(k) {
+			rest = append(rest, k)
+			continue
+		}
+		keys[k] = struct{}{}
+	}
+	s.keys, s.rest = keys, rest
+}
+
+// configure changes the comparison options and rebuilds the lookup table.
+func (s *valueSet) configure(f func(s *valueSet)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(s)
+	s.rebuild()
+}
+
+// contains checks if the set contains the given value, reloading the values from the provider when they are stale.
+func (s *valueSet) contains(value interface{}) bool {
+	s.reload()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	k := s.key(value)
+	if isHashable(k) {
+		_, ok := s.keys[k]
+		return ok
+	}
+	for _, e := range s.rest {
+		if reflect.DeepEqual(e, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *valueSet) reload() {
+	if s.provider == nil || s.refresh <= 0 {
+		return
+	}
+
+	s.mu.RLock()
+	stale := time.Since(s.loadedAt) >= s.refresh
+	s.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	values := s.provider()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reset(values)
+	s.loadedAt = time.Now()
+}
+
+// key returns the representation of a value used for lookups according to the comparison options.
+func (s *valueSet) key(value interface{}) interface{} {
+	if s.numeric {
+		if n, ok := numericKey(value); ok {
+			return n
+		}
+		if n, ok := numericStringKey(value); ok {
+			return n
+		}
+	}
+
+	if rv := reflect.ValueOf(value); (s.foldCase || s.normalize) && rv.Kind() == reflect.String {
+		k := rv.String()
+		if s.normalize {
+			k = norm.NFC.String(k)
+		}
+		if s.foldCase {
+			k = cases.Fold().String(k)
+		}
+		return k
+	}
+
+	return value
+}
+
+// numericKey converts a number of any kind into a canonical form so that, for example, int(1), int64(1),
+// uint8(1) and float64(1) are treated as the same value.
+func numericKey(value interface{}) (interface{}, bool) {
+	if n, ok := value.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return i, true
+		}
+		if f, err := n.Float64(); err == nil {
+			return floatKey(f), true
+		}
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if u := rv.Uint(); u <= math.MaxInt64 {
+			return int64(u), true
+		}
+		return rv.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return floatKey(rv.Float()), true
+	}
+
+	return nil, false
+}
+
+// numericStringKey converts a string holding a decimal number, such as a value read from a file,
+// into the same form as numericKey.
+func numericStringKey(value interface{}) (interface{}, bool) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.String {
+		return nil, false
+	}
+	n := json.Number(strings.TrimSpace(rv.String()))
+	if f, err := n.Float64(); err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, false
+	}
+	return numericKey(n)
+}
+
+func floatKey(f float64) interface{} {
+	if f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 {
+		return int64(f)
+	}
+	return f
+}
+
+// readValues reads one value per line from the reader.
+// Leading and trailing spaces are trimmed, empty lines and lines starting with # are ignored.
+func readValues(r io.Reader) ([]interface{}, error) {
+	values := make([]interface{}, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		values = append(values, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func readValuesFile(path string) ([]interface{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readValues(f)
+}
+
+// isHashable checks if a value can be used as a map key. Unlike reflect.Type.Comparable, it also looks into
+// the dynamic values of interface fields, since hashing a struct holding a slice in such a field panics.
+func isHashable(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	return isHashableValue(reflect.ValueOf(value))
+}
+
+func isHashableValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func:
+		return false
+	case reflect.Interface:
+		return v.IsNil() || isHashableValue(v.Elem())
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !isHashableValue(v.Index(i)) {
+				return false
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !isHashableValue(v.Field(i)) {
+				return false
+			}
+		}
+	}
+	return true
+}
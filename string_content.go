@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ContainsString returns a validation rule that checks if a string or byte slice contains the given substring.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ContainsString(substr string) *StringContentRule {
+	return &StringContentRule{
+		validate: func(s string) bool { return strings.Contains(s, substr) },
+		args:     []interface{}{substr},
+		code:     1310,
+	}
+}
+
+// HasPrefix returns a validation rule that checks if a string or byte slice begins with the given prefix.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func HasPrefix(prefix string) *StringContentRule {
+	return &StringContentRule{
+		validate: func(s string) bool { return strings.HasPrefix(s, prefix) },
+		args:     []interface{}{prefix},
+		code:     1311,
+	}
+}
+
+// HasSuffix returns a validation rule that checks if a string or byte slice ends with the given suffix.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func HasSuffix(suffix string) *StringContentRule {
+	return &StringContentRule{
+		validate: func(s string) bool { return strings.HasSuffix(s, suffix) },
+		args:     []interface{}{suffix},
+		code:     1312,
+	}
+}
+
+// ExcludesChars returns a validation rule that checks if a string or byte slice contains none of the given characters.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ExcludesChars(chars string) *StringContentRule {
+	return &StringContentRule{
+		validate: func(s string) bool { return !strings.ContainsAny(s, chars) },
+		args:     []interface{}{chars},
+		code:     1313,
+	}
+}
+
+// ExcludesRunes returns a validation rule that checks if a string or byte slice contains none of the given runes.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ExcludesRunes(runes ...rune) *StringContentRule {
+	return &StringContentRule{
+		validate: func(s string) bool { return !strings.ContainsAny(s, string(runes)) },
+		args:     []interface{}{string(runes)},
+		code:     1314,
+	}
+}
+
+// ContainsRuneClass returns a validation rule that checks if a string or byte slice contains
+// at least one rune of the given Unicode class, for example unicode.Digit or unicode.Upper.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ContainsRuneClass(table *unicode.RangeTable) *StringContentRule {
+	return &StringContentRule{
+		validate: func(s string) bool { return strings.IndexFunc(s, func(c rune) bool { return unicode.Is(table, c) }) >= 0 },
+		code:     1315,
+	}
+}
+
+// StringContentRule is a rule that checks the content of a string or byte slice.
+type StringContentRule struct {
+	validate stringValidator
+	args     []interface{}
+	code     int
+}
+
+// Validate checks if the given value is valid or not.
+func (r *StringContentRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return
+	}
+
+	isString, str, isBytes, bs := StringOrBytes(value)
+	if !isString && !isBytes {
+		code = 1005
+		return
+	}
+	if isBytes {
+		str = string(bs)
+	}
+
+	if !r.validate(str) {
+		code, args = r.code, r.args
+	}
+	return
+}
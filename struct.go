@@ -59,7 +59,12 @@ func ValidateStruct(structPtr interface{}, fields ...*FieldRules) goerr.IError {
 		if ft == nil {
 			return verror.NewGoErr(1003)
 		}
-		if err := Validate(fv.Elem().Interface(), fr.rules...); err != nil {
+		rules := fr.rules
+		if hasFilter(rules) {
+			// filters write the transformed value back to the field
+			rules = bindFilters(rules, fv)
+		}
+		if err := Validate(fv.Elem().Interface(), rules...); err != nil {
 			if ft.Anonymous {
 				// merge errors from anonymous struct field
 				if es, ok := err.(verror.ErrStack); ok {
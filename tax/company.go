@@ -0,0 +1,48 @@
+package tax
+
+import (
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+var (
+	reLei  = regexp.MustCompile(`^[0-9A-Z]{18}[0-9]{2}$`)
+	reDuns = regexp.MustCompile(`^[0-9]{9}$`)
+	reEin  = regexp.MustCompile(`^[0-9]{2}-?[0-9]{7}$`)
+
+	// einPrefixes are the prefixes of the EIN assigned by the IRS campuses and the online application.
+	einPrefixes = map[string]bool{}
+
+	// LEI validates a Legal Entity Identifier (ISO 17442) including its mod 97 control digits.
+	LEI = validation.NewStringRule(isLEI, 3110)
+	// DUNS validates a 9-digit D-U-N-S number, with or without dashes (e.g. "15-048-3782").
+	DUNS = validation.NewStringRule(isDUNS, 3120)
+	// EIN validates a US Employer Identification Number in the form "XX-XXXXXXX" or "XXXXXXXXX".
+	EIN = validation.NewStringRule(isEIN, 3130)
+)
+
+func init() {
+	for _, p := range strings.Fields(`
+		01 02 03 04 05 06 10 11 12 13 14 15 16 20 21 22 23 24 25 26 27
+		30 31 32 33 34 35 36 37 38 39 40 41 42 43 44 45 46 47 48
+		50 51 52 53 54 55 56 57 58 59 60 61 62 63 64 65 66 67 68
+		71 72 73 74 75 76 77 80 81 82 83 84 85 86 87 88 90 91 92 93 94 95 98 99`) {
+		einPrefixes[p] = true
+	}
+}
+
+func isLEI(value string) bool {
+	value = strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	return reLei.MatchString(value) && checksum.Mod97(value) == 1
+}
+
+func isDUNS(value string) bool {
+	return reDuns.MatchString(strings.ReplaceAll(value, "-", ""))
+}
+
+func isEIN(value string) bool {
+	return reEin.MatchString(value) && einPrefixes[value[:2]]
+}
@@ -0,0 +1,38 @@
+package tax
+
+import (
+	"testing"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+func TestCompanyIdentifiers(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  validation.Rule
+		value string
+		code  int
+	}{
+		{"LEI empty", LEI, "", 0},
+		{"LEI", LEI, "5493001KJTIIGC8Y1R12", 0},
+		{"LEI Apple", LEI, "HWUPKR0MPOU8FGXBT394", 0},
+		{"LEI lower case with spaces", LEI, "5493 001k jtii gc8y 1r12", 0},
+		{"LEI check digits off", LEI, "5493001KJTIIGC8Y1R13", 3110},
+		{"LEI character off", LEI, "5493001KJTIIGC8Y1S12", 3110},
+		{"LEI short", LEI, "5493001KJTIIGC8Y1R1", 3110},
+		{"DUNS", DUNS, "150483782", 0},
+		{"DUNS with dashes", DUNS, "15-048-3782", 0},
+		{"DUNS short", DUNS, "15048378", 3120},
+		{"DUNS letter", DUNS, "15048378A", 3120},
+		{"EIN", EIN, "12-3456789", 0},
+		{"EIN without a dash", EIN, "123456789", 0},
+		{"EIN unassigned prefix", EIN, "07-3456789", 3130},
+		{"EIN short", EIN, "12-345678", 3130},
+	}
+
+	for _, test := range tests {
+		if code, _ := test.rule.Validate(test.value); code != test.code {
+			t.Errorf("%s: got %v, want %v", test.tag, code, test.code)
+		}
+	}
+}
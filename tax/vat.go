@@ -0,0 +1,122 @@
+// Package tax provides validation rules for international tax and company identifiers.
+package tax
+
+import (
+	"regexp"
+	"strings"
+
+	validation "github.com/cadyrov/govalidation"
+)
+
+// vatCountry describes the VAT identification number of a country.
+type vatCountry struct {
+	// re matches the number without the country prefix.
+	re *regexp.Regexp
+	// check verifies the control digits of a number matched by re, nil if there are none.
+	check func(number string) bool
+}
+
+var (
+	// vatGB is the VAT number of the United Kingdom, also used with the XI prefix in Northern Ireland.
+	vatGB = vatCountry{regexp.MustCompile(`^([0-9]{9}|[0-9]{12}|GD[0-4][0-9]{2}|HA[5-9][0-9]{2})$`), checkVatGB}
+
+	// vatCountries are the VAT number formats keyed by the VIES country prefix.
+	vatCountries = map[string]vatCountry{
+		"AT": {regexp.MustCompile(`^U[0-9]{8}$`), checkVatAT},
+		"BE": {regexp.MustCompile(`^[01][0-9]{9}$`), checkVatBE},
+		"BG": {regexp.MustCompile(`^[0-9]{9,10}$`), checkVatBG},
+		"CY": {regexp.MustCompile(`^[0-59][0-9]{7}[A-Z]$`), checkVatCY},
+		"CZ": {regexp.MustCompile(`^[0-9]{8,10}$`), checkVatCZ},
+		"DE": {regexp.MustCompile(`^[1-9][0-9]{8}$`), checkMod1110},
+		"DK": {regexp.MustCompile(`^[1-9][0-9]{7}$`), checkVatDK},
+		"EE": {regexp.MustCompile(`^10[0-9]{7}$`), checkVatEE},
+		"EL": {regexp.MustCompile(`^[0-9]{9}$`), checkVatEL},
+		"ES": {regexp.MustCompile(`^[0-9A-Z][0-9]{7}[0-9A-Z]$`), checkVatES},
+		"FI": {regexp.MustCompile(`^[0-9]{8}$`), checkVatFI},
+		"FR": {regexp.MustCompile(`^[0-9A-HJ-NP-Z]{2}[0-9]{9}$`), checkVatFR},
+		"GB": vatGB,
+		"HR": {regexp.MustCompile(`^[0-9]{11}$`), checkMod1110},
+		"HU": {regexp.MustCompile(`^[0-9]{8}$`), checkVatHU},
+		"IE": {regexp.MustCompile(`^([0-9]{7}[A-W][A-IW]?|[0-9][A-Z+*][0-9]{5}[A-W])$`), checkVatIE},
+		"IT": {regexp.MustCompile(`^[0-9]{11}$`), checkVatIT},
+		"LT": {regexp.MustCompile(`^([0-9]{9}|[0-9]{12})$`), checkVatLT},
+		"LU": {regexp.MustCompile(`^[0-9]{8}$`), checkVatLU},
+		"LV": {regexp.MustCompile(`^[0-9]{11}$`), checkVatLV},
+		"MT": {regexp.MustCompile(`^[1-9][0-9]{7}$`), checkVatMT},
+		"NL": {regexp.MustCompile(`^[0-9]{9}B[0-9]{2}$`), checkVatNL},
+		"PL": {regexp.MustCompile(`^[0-9]{10}$`), checkVatPL},
+		"PT": {regexp.MustCompile(`^[1-9][0-9]{8}$`), checkVatPT},
+		"RO": {regexp.MustCompile(`^[1-9][0-9]{1,9}$`), checkVatRO},
+		"SE": {regexp.MustCompile(`^[0-9]{10}01$`), checkVatSE},
+		"SI": {regexp.MustCompile(`^[1-9][0-9]{7}$`), checkVatSI},
+		"SK": {regexp.MustCompile(`^[1-9][0-9][2-46-9][0-9]{7}$`), checkVatSK},
+		"XI": vatGB,
+	}
+
+	// vatAliases maps ISO 3166 country codes to the VIES prefixes that differ from them.
+	vatAliases = map[string]string{"GR": "EL"}
+
+	// vatSeparators are removed from a VAT number before it is checked.
+	vatSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "/", "")
+
+	// VAT validates a VAT identification number prefixed with its country code, e.g. "DE136695976".
+	VAT = validation.NewStringRule(isVAT, 3100)
+)
+
+// VATNumber returns a validation rule that checks a VAT identification number of the given country.
+// The country is an ISO 3166 alpha-2 code ("GR" and "EL" are both accepted for Greece, "XI" is accepted
+// for Northern Ireland) and the number may be given with or without the country prefix.
+// If the country is not supported, every non-empty value is rejected with its own error code.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func VATNumber(country string) *validation.StringRule {
+	country = strings.ToUpper(country)
+	if alias, ok := vatAliases[country]; ok {
+		country = alias
+	}
+
+	c, ok := vatCountries[country]
+	if !ok {
+		return validation.NewStringRule(func(string) bool { return false }, 3102)
+	}
+
+	// the number may be prefixed with the VIES code or with any ISO code that is an alias of it
+	prefixes := []string{country}
+	for iso, alias := range vatAliases {
+		if alias == country {
+			prefixes = append(prefixes, iso)
+		}
+	}
+
+	return validation.NewStringRule(func(value string) bool {
+		number := normalizeVat(value)
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(number, prefix) {
+				number = number[len(prefix):]
+				break
+			}
+		}
+		return c.valid(number)
+	}, 3101)
+}
+
+func isVAT(value string) bool {
+	number := normalizeVat(value)
+	if len(number) < 2 {
+		return false
+	}
+	country := number[:2]
+	if alias, ok := vatAliases[country]; ok {
+		country = alias
+	}
+
+	c, ok := vatCountries[country]
+	return ok && c.valid(number[2:])
+}
+
+func (c vatCountry) valid(number string) bool {
+	return c.re.MatchString(number) && (c.check == nil || c.check(number))
+}
+
+func normalizeVat(value string) string {
+	return strings.ToUpper(vatSeparators.Replace(strings.TrimSpace(value)))
+}
@@ -0,0 +1,299 @@
+package tax
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cadyrov/govalidation/checksum"
+)
+
+func checkVatAT(n string) bool {
+	sum := 0
+	for i := 1; i < 8; i++ {
+		d := digit(n, i)
+		if i%2 == 0 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	return (10-(sum+4)%10)%10 == digit(n, 8)
+}
+
+func checkVatBE(n string) bool {
+	first, _ := strconv.Atoi(n[:8])
+	last, _ := strconv.Atoi(n[8:])
+	return 97-first%97 == last
+}
+
+func checkVatBG(n string) bool {
+	if len(n) == 9 {
+		c := checksum.Weighted{1, 2, 3, 4, 5, 6, 7, 8}.Sum(n[:8]) % 11
+		if c == 10 {
+			c = checksum.Weighted{3, 4, 5, 6, 7, 8, 9, 10}.Sum(n[:8]) % 11 % 10
+		}
+		return c == digit(n, 8)
+	}
+
+	// A ten-digit number is the personal number of a citizen, of a foreigner or of another taxpayer.
+	if c := (checksum.Weighted{2, 4, 8, 5, 10, 9, 7, 3, 6}).Sum(n[:9]) % 11 % 10; c == digit(n, 9) {
+		return true
+	}
+	if c := (checksum.Weighted{21, 19, 17, 13, 11, 9, 7, 3, 1}).Sum(n[:9]) % 10; c == digit(n, 9) {
+		return true
+	}
+	c := 11 - checksum.Weighted{4, 3, 2, 7, 6, 5, 4, 3, 2}.Sum(n[:9])%11
+	return c < 10 && c%11 == digit(n, 9) || c == 11 && digit(n, 9) == 0
+}
+
+func checkVatCY(n string) bool {
+	odd := []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		if i%2 == 0 {
+			sum += odd[digit(n, i)]
+		} else {
+			sum += digit(n, i)
+		}
+	}
+	return n[8] == byte('A'+sum%26) && !strings.HasPrefix(n, "12")
+}
+
+func checkVatCZ(n string) bool {
+	switch {
+	case len(n) == 8:
+		// A legal entity.
+		if n[0] == '9' {
+			return false
+		}
+		c := (11 - checksum.Weighted{8, 7, 6, 5, 4, 3, 2}.Sum(n[:7])%11) % 11
+		if c == 0 {
+			c = 1
+		}
+		return c%10 == digit(n, 7)
+	case len(n) == 9 && n[0] == '6':
+		// An individual without a birth number.
+		c := checksum.Weighted{8, 7, 6, 5, 4, 3, 2}.Sum(n[1:8]) % 11
+		return 9-(11-c)%10 == digit(n, 8)
+	case len(n) == 9:
+		// A birth number issued before 1954 has no control digit.
+		year, _ := strconv.Atoi(n[:2])
+		return year < 54 && validBirthMonth(n[2:4])
+	default:
+		number, _ := strconv.ParseInt(n, 10, 64)
+		first, _ := strconv.ParseInt(n[:9], 10, 64)
+		return validBirthMonth(n[2:4]) && (number%11 == 0 || first%11 == 10 && n[9] == '0')
+	}
+}
+
+// validBirthMonth checks the month of a Czech birth number, increased by 50 for women
+// and by 20 when the numbers of the day are exhausted.
+func validBirthMonth(s string) bool {
+	m, _ := strconv.Atoi(s)
+	for _, offset := range []int{0, 20, 50, 70} {
+		if m > offset && m <= offset+12 {
+			return true
+		}
+	}
+	return false
+}
+
+func checkVatDK(n string) bool {
+	return checksum.Weighted{2, 7, 6, 5, 4, 3, 2, 1}.Sum(n)%11 == 0
+}
+
+func checkVatEE(n string) bool {
+	return checksum.Weighted{3, 7, 1, 3, 7, 1, 3, 7, 1}.Sum(n)%10 == 0
+}
+
+func checkVatEL(n string) bool {
+	return checksum.Weighted{256, 128, 64, 32, 16, 8, 4, 2}.Sum(n[:8])%11%10 == digit(n, 8)
+}
+
+func checkVatES(n string) bool {
+	const letters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+	switch first := n[0]; {
+	case first >= '0' && first <= '9':
+		// The DNI of a citizen.
+		number, _ := strconv.Atoi(n[:8])
+		return n[8] == letters[number%23]
+	case strings.IndexByte("XYZ", first) >= 0:
+		// The NIE of a foreigner, the first letter standing for a digit.
+		number, _ := strconv.Atoi(string('0'+first-'X') + n[1:8])
+		return n[8] == letters[number%23]
+	case strings.IndexByte("KLM", first) >= 0:
+		number, _ := strconv.Atoi(n[1:8])
+		return n[8] == letters[number%23]
+	case strings.IndexByte("ABCDEFGHJNPQRSUVW", first) >= 0:
+		// The CIF of a legal entity, the control character is a digit or a letter.
+		if !isDigits(n[1:8]) {
+			return false
+		}
+		c := checksum.LuhnDigit(n[1:8])
+		return n[8] == byte('0'+c) || n[8] == "JABCDEFGHI"[c]
+	}
+	return false
+}
+
+func checkVatFI(n string) bool {
+	c := 11 - checksum.Weighted{7, 9, 10, 5, 8, 4, 2}.Sum(n[:7])%11
+	if c == 11 {
+		c = 0
+	}
+	return c == digit(n, 7)
+}
+
+func checkVatFR(n string) bool {
+	siren := n[2:]
+	if !checksum.Luhn(siren) {
+		return false
+	}
+	// A key containing letters is issued to new taxpayers and has no published algorithm.
+	if !isDigits(n[:2]) {
+		return true
+	}
+	key, _ := strconv.Atoi(n[:2])
+	number, _ := strconv.Atoi(siren)
+	return key == (12+3*(number%97))%97
+}
+
+func checkVatGB(n string) bool {
+	if strings.HasPrefix(n, "GD") || strings.HasPrefix(n, "HA") {
+		// A government department or a health authority.
+		return true
+	}
+	sum := checksum.Weighted{8, 7, 6, 5, 4, 3, 2}.Sum(n[:7])
+	c, _ := strconv.Atoi(n[7:9])
+	return (sum+c)%97 == 0 || (sum+c+55)%97 == 0
+}
+
+func checkVatHU(n string) bool {
+	return checksum.Weighted{9, 7, 3, 1, 9, 7, 3, 1}.Sum(n)%10 == 0
+}
+
+func checkVatIE(n string) bool {
+	if !isDigits(n[1:2]) {
+		// The old format: the second character is moved into the control part.
+		n = "0" + n[2:7] + n[:1] + n[7:]
+	}
+	sum := checksum.Weighted{8, 7, 6, 5, 4, 3, 2}.Sum(n[:7])
+	if len(n) == 9 && n[8] != 'W' {
+		sum += int(n[8]-'A'+1) * 9
+	}
+	return n[7] == "WABCDEFGHIJKLMNOPQRSTUV"[sum%23]
+}
+
+func checkVatIT(n string) bool {
+	office, _ := strconv.Atoi(n[7:10])
+	validOffice := office > 0 && office <= 100 || office == 120 || office == 121 || office == 888 || office == 999
+	return n[:7] != "0000000" && validOffice && checksum.Luhn(n)
+}
+
+func checkVatLT(n string) bool {
+	if n[len(n)-2] != '1' {
+		return false
+	}
+	sum, sum2 := 0, 0
+	for i := 0; i < len(n)-1; i++ {
+		sum += (1 + i%9) * digit(n, i)
+		sum2 += (1 + (i+2)%9) * digit(n, i)
+	}
+	c := sum % 11
+	if c == 10 {
+		c = sum2 % 11
+	}
+	return c%10 == digit(n, len(n)-1)
+}
+
+func checkVatLU(n string) bool {
+	first, _ := strconv.Atoi(n[:6])
+	last, _ := strconv.Atoi(n[6:])
+	return first%89 == last
+}
+
+func checkVatLV(n string) bool {
+	switch {
+	case n[0] > '3':
+		// A legal entity.
+		return checksum.Weighted{9, 1, 4, 8, 3, 10, 2, 5, 7, 6, 1}.Sum(n)%11 == 3
+	case strings.HasPrefix(n, "32"):
+		// A personal code issued since 2017 has no control digit.
+		return true
+	default:
+		c := (1 - checksum.Weighted{10, 5, 8, 4, 2, 1, 6, 3, 7, 9}.Sum(n[:10])%11 + 11) % 11
+		return c < 10 && c == digit(n, 10)
+	}
+}
+
+func checkVatMT(n string) bool {
+	return checksum.Weighted{3, 4, 6, 7, 8, 9, 10, 1}.Sum(n)%37 == 0
+}
+
+func checkVatNL(n string) bool {
+	// The VAT number of a sole trader is checked as a whole with ISO 7064 mod 97-10.
+	if checksum.Mod97("NL"+n) == 1 {
+		return true
+	}
+	sum := checksum.Weighted{9, 8, 7, 6, 5, 4, 3, 2}.Sum(n[:8])
+	return (sum-digit(n, 8))%11 == 0
+}
+
+func checkVatPL(n string) bool {
+	return checksum.Weighted{6, 5, 7, 2, 3, 4, 5, 6, 7}.Sum(n[:9])%11 == digit(n, 9)
+}
+
+func checkVatPT(n string) bool {
+	c := 11 - checksum.Weighted{9, 8, 7, 6, 5, 4, 3, 2}.Sum(n[:8])%11
+	if c > 9 {
+		c = 0
+	}
+	return c == digit(n, 8)
+}
+
+func checkVatRO(n string) bool {
+	n = strings.Repeat("0", 10-len(n)) + n
+	return checksum.Weighted{7, 5, 3, 2, 1, 7, 5, 3, 2}.Sum(n[:9])*10%11%10 == digit(n, 9)
+}
+
+func checkVatSE(n string) bool {
+	return checksum.Luhn(n[:10])
+}
+
+func checkVatSI(n string) bool {
+	c := 11 - checksum.Weighted{8, 7, 6, 5, 4, 3, 2}.Sum(n[:7])%11
+	if c == 10 {
+		c = 0
+	}
+	return c == digit(n, 7)
+}
+
+func checkVatSK(n string) bool {
+	number, _ := strconv.ParseInt(n, 10, 64)
+	return number%11 == 0
+}
+
+// checkMod1110 checks the control digit computed with ISO 7064 mod 11,10.
+func checkMod1110(n string) bool {
+	p := 10
+	for i := 0; i < len(n)-1; i++ {
+		s := (digit(n, i) + p) % 10
+		if s == 0 {
+			s = 10
+		}
+		p = s * 2 % 11
+	}
+	return (11-p)%10 == digit(n, len(n)-1)
+}
+
+func digit(s string, i int) int {
+	return int(s[i] - '0')
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package tax
+
+import "testing"
+
+// validVATs are known valid VAT numbers of every supported country.
+var validVATs = []string{
+	"ATU13585627", "BE0411905847", "BG175074752", "CY10259033P", "CZ25123891", "DE136695976", "DK13585628",
+	"EE100931558", "EL094259216", "ESA28015865", "ESB58378431", "FI20774740", "FR40303265045", "GB980780684",
+	"GB434031494", "GB242338087388", "GBGD001", "HR33392005961", "HU12892312", "IE6388047V", "IT00743110157",
+	"LT119511515", "LU15027442", "LV40003521600", "MT11679112", "NL004495445B01", "PL5260250995", "PT501964843",
+	"RO18547290", "SE556188840401", "SI50223054", "SK2022749619", "XI980780684",
+}
+
+func TestVAT(t *testing.T) {
+	for _, value := range validVATs {
+		if code, _ := VAT.Validate(value); code != 0 {
+			t.Errorf("%v: got %v, want 0", value, code)
+		}
+	}
+
+	tests := []struct {
+		value string
+		code  int
+	}{
+		{"", 0},
+		{"de 136 695 976", 0},
+		{"GR094259216", 0},
+		{"ATU13585626", 3100},
+		{"BE0411905848", 3100},
+		{"DE136695977", 3100},
+		{"DE136695986", 3100},
+		{"ESA28015866", 3100},
+		{"FR40303265046", 3100},
+		{"GB980780685", 3100},
+		{"IT00743110158", 3100},
+		{"NL004495446B01", 3100},
+		{"PL5260250996", 3100},
+		{"XI980780685", 3100},
+		{"US123456789", 3100},
+		{"D", 3100},
+	}
+
+	for _, test := range tests {
+		if code, _ := VAT.Validate(test.value); code != test.code {
+			t.Errorf("%v: got %v, want %v", test.value, code, test.code)
+		}
+	}
+}
+
+func TestVATNumber(t *testing.T) {
+	tests := []struct {
+		country string
+		value   string
+		code    int
+	}{
+		{"DE", "", 0},
+		{"DE", "136695976", 0},
+		{"de", "DE136695976", 0},
+		{"DE", "136695977", 3101},
+		{"DE", "FR40303265045", 3101},
+		{"GR", "094259216", 0},
+		{"GR", "GR094259216", 0},
+		{"GR", "EL 094259216", 0},
+		{"gr", "GR094259217", 3101},
+		{"EL", "EL094259216", 0},
+		{"EL", "GR094259216", 0},
+		{"XI", "980780684", 0},
+		{"XI", "XI 980 7806 84", 0},
+		{"XI", "980780685", 3101},
+		{"GB", "980780684", 0},
+		{"US", "123456789", 3102},
+	}
+
+	for _, test := range tests {
+		if code, _ := VATNumber(test.country).Validate(test.value); code != test.code {
+			t.Errorf("%v %v: got %v, want %v", test.country, test.value, code, test.code)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Unique returns a validation rule that checks if the elements of a slice or an array, or the values of a map, are unique.
+// The error args contain the indexes (or map keys) of all the duplicated elements, including the first occurrences.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Unique() *UniqueRule {
+	return &UniqueRule{code: 1108}
+}
+
+// UniqueBy returns a validation rule that checks if the keys returned by the given function are unique
+// for every element of a slice or an array, or every value of a map.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func UniqueBy(key func(elem interface{}) interface{}) *UniqueRule {
+	return &UniqueRule{key: key, code: 1108}
+}
+
+type UniqueRule struct {
+	key  func(elem interface{}) interface{}
+	code int
+}
+
+// Validate checks if the given value is valid or not.
+func (r *UniqueRule) Validate(value interface{}) (code int, args []interface{}) {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array && rv.Kind() != reflect.Map {
+		code = 1006
+		return
+	}
+
+	elements := elementsOf(rv, false)
+	// first maps a key to the position of the first element having it, duplicated marks the reported elements.
+	first := make(map[interface{}]int)
+	rest := make([]interface{}, 0)
+	restPositions := make([]int, 0)
+	duplicated := make([]bool, len(elements))
+
+	for i, e := range elements {
+		k := e.value
+		if r.key != nil {
+			k = r.key(k)
+		}
+
+		if !isHashable(k) {
+			for j := range rest {
+				if reflect.DeepEqual(rest[j], k) {
+					duplicated[restPositions[j]], duplicated[i] = true, true
+					break
+				}
+			}
+			rest, restPositions = append(rest, k), append(restPositions, i)
+			continue
+		}
+
+		if j, ok := first[k]; ok {
+			duplicated[j], duplicated[i] = true, true
+			continue
+		}
+		first[k] = i
+	}
+
+	duplicates := make([]string, 0)
+	for i, e := range elements {
+		if duplicated[i] {
+			duplicates = append(duplicates, e.label)
+		}
+	}
+	if len(duplicates) > 0 {
+		code, args = r.code, []interface{}{duplicates}
+	}
+	return
+}
+
+// element is an element of a collection along with its index or map key.
+type element struct {
+	label string
+	value interface{}
+}
+
+// elementsOf returns the elements of a slice or an array in their order.
+// For a map it returns either the keys or the values, sorted by the printed key to keep the errors stable.
+func elementsOf(rv reflect.Value, mapKeys bool) []element {
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		res := make([]element, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			res[i] = element{label: strconv.Itoa(i), value: rv.Index(i).Interface()}
+		}
+		return res
+	case reflect.Map:
+		res := make([]element, 0, rv.Len())
+		for _, k := range rv.MapKeys() {
+			e := element{label: fmt.Sprintf("%v", k.Interface()), value: k.Interface()}
+			if !mapKeys {
+				e.value = rv.MapIndex(k).Interface()
+			}
+			res = append(res, e)
+		}
+		sort.Slice(res, func(i, j int) bool { return res[i].label < res[j].label })
+		return res
+	}
+
+	return nil
+}
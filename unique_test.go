@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnique(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *UniqueRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", Unique(), []int{}, 0, nil},
+		{"nil", Unique(), []int(nil), 0, nil},
+		{"unique", Unique(), []int{1, 2, 3}, 0, nil},
+		{"duplicated", Unique(), []int{1, 2, 1}, 1108, []interface{}{[]string{"0", "2"}}},
+		{"three times", Unique(), []string{"a", "a", "b", "a"}, 1108, []interface{}{[]string{"0", "1", "3"}}},
+		{"two pairs", Unique(), []int{1, 2, 2, 1}, 1108, []interface{}{[]string{"0", "1", "2", "3"}}},
+		{"array", Unique(), [3]int{1, 2, 2}, 1108, []interface{}{[]string{"1", "2"}}},
+		{"map values", Unique(), map[string]int{"a": 1, "b": 2, "c": 1}, 1108, []interface{}{[]string{"a", "c"}}},
+		{"slices", Unique(), [][]int{{1}, {2}, {1}}, 1108, []interface{}{[]string{"0", "2"}}},
+		{"slices in interfaces", Unique(), []interface{}{inTestPair{"a", []int{1}}, inTestPair{"a", []int{1}}}, 1108,
+			[]interface{}{[]string{"0", "1"}}},
+		{"mixed", Unique(), []interface{}{1, []int{1}, "1"}, 0, nil},
+		{"by key", UniqueBy(func(e interface{}) interface{} { return strings.ToLower(e.(string)) }),
+			[]string{"Moscow", "Kazan", "MOSCOW"}, 1108, []interface{}{[]string{"0", "2"}}},
+		{"by unhashable key", UniqueBy(func(e interface{}) interface{} { return []string{e.(string)[:1]} }),
+			[]string{"ab", "ba", "ac"}, 1108, []interface{}{[]string{"0", "2"}}},
+		{"not a collection", Unique(), "abc", 1006, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  *ContainsRule
+		value interface{}
+		code  int
+		args  []interface{}
+	}{
+		{"empty", Contains(1), []int{}, 0, nil},
+		{"contains", Contains(2), []int{1, 2}, 0, nil},
+		{"missing", Contains(3), []int{1, 2}, 1109, []interface{}{[]interface{}{3}}},
+		{"map keys", Contains("b"), map[string]int{"a": 1, "b": 2}, 0, nil},
+		{"all", ContainsAll(1, 2), []int{2, 1, 3}, 0, nil},
+		{"all missing", ContainsAll(1, 4, 5), []int{1, 2}, 1109, []interface{}{[]interface{}{4, 5}}},
+		{"any", ContainsAny(4, 2), []int{1, 2}, 0, nil},
+		{"any missing", ContainsAny(4, 5), []int{1, 2}, 1110, []interface{}{[]interface{}{4, 5}}},
+		{"unhashable", Contains([]int{1}), [][]int{{1}, {2}}, 0, nil},
+		{"subset", SubsetOf("a", "b", "c"), []string{"c", "a"}, 0, nil},
+		{"not a subset", SubsetOf("a", "b"), []string{"a", "x", "y"}, 1111, []interface{}{[]interface{}{"x", "y"}}},
+		{"not a collection", Contains(1), 1, 1006, nil},
+	}
+
+	for _, test := range tests {
+		code, args := test.rule.Validate(test.value)
+		if code != test.code || !reflect.DeepEqual(args, test.args) {
+			t.Errorf("%s: got %v %v, want %v %v", test.tag, code, args, test.code, test.args)
+		}
+	}
+}
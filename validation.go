@@ -41,7 +41,7 @@ var (
 // Validate validates the given value and returns the validation error, if any.
 //
 // Validate performs validation using the following steps:
-// - validate the value against the rules passed in as parameters
+// - validate the value against the rules passed in as parameters, filters transform the value for the rules following them
 // - if the value is a map and the map values implement `Validatable`, call `Validate` of every map value
 // - if the value is a slice or array whose values implement `Validatable`, call `Validate` of every element
 func Validate(value interface{}, rules ...Rule) goerr.IError {
@@ -49,6 +49,10 @@ func Validate(value interface{}, rules ...Rule) goerr.IError {
 		if _, ok := rule.(*skipRule); ok {
 			return nil
 		}
+		if f, ok := rule.(Filter); ok {
+			value = applyFilter(f, value)
+			continue
+		}
 		if code, args := rule.Validate(value); code != 0 {
 			return verror.NewGoErr(code, args...)
 		}
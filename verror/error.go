@@ -23,6 +23,12 @@ var mpErr map[int]string = map[int]string{
 	1105: "must_be_in_a_valid_format",
 	1106: "must_be_multiple_of_%v",
 	1107: "must_not_be_in_list",
+	1108: "must_contain_unique_values_duplicated_%v",
+	1109: "must_contain_%v",
+	1110: "must_contain_any_of_%v",
+	1111: "values_%v_are_not_allowed",
+	1112: "must_be_equal_to_%v",
+	1113: "must_not_be_equal_to_%v",
 
 	1201: "is_required",
 	1202: "cannot_be_blank",
@@ -33,6 +39,13 @@ var mpErr map[int]string = map[int]string{
 	1303: "the_length_must_be_exactly_%v",
 	1304: "the_length_must_be_between_%v_and_%v",
 
+	1310: "must_contain_%q",
+	1311: "must_start_with_%q",
+	1312: "must_end_with_%q",
+	1313: "must_not_contain_any_of_characters_%q",
+	1314: "must_not_contain_any_of_runes_%q",
+	1315: "must_contain_a_character_of_the_required_class",
+
 	1401: "must_be_a_valid_email_address",
 
 	1501: "must_contain_English_letters_only",
@@ -57,6 +70,16 @@ var mpErr map[int]string = map[int]string{
 	1902: "must_be_a_valid_UUID_v4",
 	1903: "must_be_a_valid_UUID_v5",
 	1904: "must_be_a_valid_UUID",
+	1905: "must_be_a_valid_UUID_v1",
+	1906: "must_be_a_valid_UUID_v6",
+	1907: "must_be_a_valid_UUID_v7",
+	1908: "must_be_a_valid_UUID_v8",
+	1910: "must_be_a_valid_ULID",
+	1911: "ulid_time_is_out_of_range",
+	1912: "must_be_a_valid_KSUID",
+	1913: "must_be_a_valid_NanoID",
+	1914: "must_be_a_valid_snowflake_ID",
+	1915: "snowflake_ID_time_is_out_of_range",
 
 	2001: "must_be_a_valid_credit_card_number",
 	2002: "must_be_a_valid_ISBN_10",
@@ -100,6 +123,10 @@ var mpErr map[int]string = map[int]string{
 	2701: "must_be_a_valid_social_security_number",
 	2702: "must_be_a_valid_semantic_version",
 
+	// 28xx are the Russian tax identifiers, a decade per identifier. The other Russian identifiers continue
+	// in 29xx: bank requisites in 290x-292x, classifiers in 294x, personal documents in 295x-296x,
+	// vehicles in 297x, addresses in 298x and phones in 299x.
+	2809: "numeric_value_has_lost_leading_zeros_pass_it_as_a_string",
 	2810: "inn_10_simbols_not_correct",
 	2811: "only 10 digits",
 	2812: "control_sum_is_invalid",
@@ -110,12 +137,148 @@ var mpErr map[int]string = map[int]string{
 	2830: "inn_not_correct",
 	2840: "ogrn_Law_not_correct",
 	2841: "only_13_digits",
+	2842: "control_sum_is_invalid",
 	2850: "ogrn_IP_not_correct",
 	2851: "only_15_digits",
 	2852: "control_sum_is_invalid",
 	2860: "ogrn_not_correct",
 	2870: "okato_not_correct",
 	2880: "snils_not_correct",
+	2890: "kpp_not_correct",
+	2891: "kpp_reason_code_is_invalid",
+	2892: "kpp_must_be_issued_in_the_region_%v_of_the_inn",
+	2893: "kpp_requires_a_valid_10_digit_inn",
+	2900: "bik_not_correct",
+	2901: "bik_must_start_with_the_country_code_04_or_the_treasury_codes_00_and_01",
+	2902: "bik_region_code_is_invalid",
+	2903: "account_requires_a_valid_bik",
+	2910: "account_not_correct_only_20_digits",
+	2911: "account_control_key_is_invalid",
+	2912: "account_currency_code_%v_is_invalid",
+	2920: "corr_account_must_start_with_30101_or_40102",
+	2921: "corr_account_does_not_match_the_bik",
+	2940: "oktmo_not_correct",
+	2941: "okved_not_correct",
+	2942: "okopf_not_correct",
+	2943: "okfs_not_correct",
+	2944: "okogu_not_correct",
+	2945: "code_%v_is_not_found_in_the_directory",
+	2950: "passport_not_correct",
+	2951: "passport_division_code_not_correct",
+	2952: "driver_license_not_correct",
+	2953: "oms_policy_not_correct_only_16_digits",
+	2954: "oms_policy_control_sum_is_invalid",
+	2955: "birth_certificate_not_correct",
+	2956: "birth_certificate_series_not_correct",
+	2957: "passport_series_not_correct",
+	2958: "passport_number_not_correct",
+	2960: "passport_issue_date_is_before_the_birth_date",
+	2961: "passport_issued_before_the_age_of_14",
+	2962: "passport_issue_date_is_in_the_future",
+	2963: "passport_expired_at_the_age_of_%v",
+	2970: "vin_not_correct",
+	2971: "vin_check_digit_is_invalid",
+	2972: "license_plate_not_correct",
+	2973: "sts_not_correct",
+	2974: "pts_not_correct",
+	2975: "epts_not_correct",
+	2980: "cadastral_number_not_correct",
+	2981: "cadastral_okrug_code_is_invalid",
+	2982: "cadastral_district_%v_is_not_found_in_the_directory",
+	2983: "postal_index_not_correct",
+	2984: "postal_index_%v_does_not_belong_to_the_region",
+	2985: "fias_guid_not_correct",
+	2986: "cadastral_district_%v_is_out_of_range_of_okrug_%v",
+	2987: "cadastral_quarter_%v_is_out_of_range_of_okrug_%v",
+	2990: "phone_not_correct",
+	2991: "phone_code_%v_is_not_used_in_russia",
+	2992: "phone_must_be_mobile",
+
+	3010: "kz_iin_not_correct",
+	3011: "kz_only_12_digits",
+	3012: "kz_control_sum_is_invalid",
+	3013: "kz_iin_birth_date_is_invalid",
+	3014: "kz_bin_not_correct",
+	3015: "kz_bin_registration_data_is_invalid",
+	3016: "kz_iin_or_bin_not_correct",
+	3020: "by_unp_not_correct",
+	3021: "by_unp_control_sum_is_invalid",
+	3031: "uz_pinfl_not_correct",
+	3032: "uz_pinfl_birth_date_is_invalid",
+	3033: "uz_pinfl_control_sum_is_invalid",
+
+	3100: "vat_number_not_correct",
+	3101: "vat_number_of_country_not_correct",
+	3102: "vat_country_not_supported",
+	3110: "lei_not_correct",
+	3120: "duns_not_correct",
+	3130: "ein_not_correct",
+
+	3200: "iban_not_correct",
+	3201: "iban_length_for_%v_must_be_%v",
+	3202: "iban_country_%v_not_supported",
+	3203: "iban_bban_structure_is_invalid",
+	3204: "iban_control_sum_is_invalid",
+	3210: "bic_not_correct",
+	3211: "bic_country_%v_is_invalid",
+	3220: "sepa_creditor_id_not_correct",
+
+	3300: "card_number_not_correct",
+	3301: "card_number_control_sum_is_invalid",
+	3302: "card_brand_%v_is_not_accepted",
+	3303: "card_brand_is_unknown",
+	3304: "card_number_length_is_invalid_for_%v",
+	3310: "card_expiry_date_not_correct",
+	3311: "card_is_expired",
+	3320: "cvv_not_correct",
+	3321: "cvv_must_have_%v_digits",
+
+	3401: "must_be_a_valid_EAN_8",
+	3402: "must_be_a_valid_EAN_13",
+	3403: "must_be_a_valid_UPC_A",
+	3404: "must_be_a_valid_UPC_E",
+	3405: "must_be_a_valid_GTIN_14",
+	3406: "must_be_a_valid_GTIN",
+	3410: "must_be_a_valid_ISSN",
+	3411: "must_be_a_valid_ISMN",
+	3420: "must_be_a_valid_ISIN",
+	3421: "must_be_a_valid_CUSIP",
+	3422: "must_be_a_valid_SEDOL",
+
+	3501: "must_be_a_valid_IMEI",
+	3502: "must_be_a_valid_IMEISV",
+	3503: "must_be_a_valid_ICCID",
+	3504: "must_be_a_valid_IMSI",
+	3505: "must_be_a_valid_MEID",
+	3506: "must_be_a_valid_MSISDN",
+
+	3700: "must_be_a_valid_URL",
+	3701: "url_scheme_%v_is_not_allowed",
+	3702: "url_host_%v_is_not_allowed",
+	3703: "url_must_not_contain_credentials",
+	3704: "url_port_%v_is_not_allowed",
+	3705: "url_length_must_be_no_more_than_%v",
+	3706: "url_host_%v_is_not_a_public_address",
+	3707: "url_host_%v_cannot_be_resolved",
+
+	3800: "must_be_a_valid_IP",
+	3801: "must_be_a_valid_CIDR",
+	3802: "cidr_prefix_length_must_be_between_%v_and_%v",
+	3803: "ip_must_be_in_%v",
+	3804: "ip_must_not_be_in_%v",
+	3805: "must_be_a_private_IP",
+	3806: "must_be_a_public_IP",
+	3807: "must_be_a_valid_port_range",
+	3808: "must_be_a_valid_port",
+	3809: "must_be_a_valid_IP_range",
+
+	3900: "must_be_a_valid_email",
+	3901: "email_display_name_is_not_allowed",
+	3902: "email_%v_length_must_be_no_more_than_%v",
+	3903: "email_plus_addressing_is_not_allowed",
+	3904: "email_domain_%v_is_not_allowed",
+	3905: "email_domain_%v_is_disposable",
+	3906: "email_domain_%v_cannot_receive_mail",
 }
 
 type ErrStack goerr.IError